@@ -0,0 +1,45 @@
+package scaleway
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceScalewayAvailabilityZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayAvailabilityZonesRead,
+		Schema: map[string]*schema.Schema{
+			"region": regionSchema(),
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of availability zones in the region",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayAvailabilityZonesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, err := extractRegion(d, meta.(*Meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zones := []string(nil)
+	for _, zone := range region.GetZones() {
+		zones = append(zones, zone.String())
+	}
+	sort.Strings(zones)
+
+	d.SetId(region.String())
+	_ = d.Set("region", region.String())
+	_ = d.Set("zones", zones)
+
+	return nil
+}
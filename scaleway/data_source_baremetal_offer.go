@@ -127,6 +127,14 @@ func dataSourceScalewayBaremetalOffer() *schema.Resource {
 				Computed:    true,
 				Description: "Stock status for this offer",
 			},
+			"incompatible_os_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the os that can not be installed with this offer",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -149,7 +157,7 @@ func dataSourceScalewayBaremetalOfferRead(ctx context.Context, d *schema.Resourc
 	for _, offer := range res.Offers {
 		if offer.Name == d.Get("name") || offer.ID == offerID {
 			if !offer.Enable && !d.Get("include_disabled").(bool) {
-				return diag.FromErr(fmt.Errorf("offer %s (%s) found in zone %s but is disabled. Add allow_disabled=true in your terraform config to use it", offer.Name, offer.ID, zone))
+				return diag.FromErr(fmt.Errorf("offer %s (%s) found in zone %s but is disabled. Add include_disabled=true in your terraform config to use it", offer.Name, offer.ID, zone))
 			}
 			matches = append(matches, offer)
 		}
@@ -173,7 +181,13 @@ func dataSourceScalewayBaremetalOfferRead(ctx context.Context, d *schema.Resourc
 	_ = d.Set("cpu", flattenBaremetalCPUs(offer.CPUs))
 	_ = d.Set("disk", flattenBaremetalDisks(offer.Disks))
 	_ = d.Set("memory", flattenBaremetalMemory(offer.Memories))
+	// an out of stock offer is not an error, the stock status is exposed so it can be checked in the configuration
 	_ = d.Set("stock", offer.Stock.String())
+	incompatibleOsIDs := []string(nil)
+	for _, osID := range offer.IncompatibleOsIDs {
+		incompatibleOsIDs = append(incompatibleOsIDs, newZonedIDString(zone, osID))
+	}
+	_ = d.Set("incompatible_os_ids", incompatibleOsIDs)
 
 	return nil
 }
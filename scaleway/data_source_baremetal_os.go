@@ -34,6 +34,16 @@ func dataSourceScalewayBaremetalOs() *schema.Resource {
 				ConflictsWith: []string{"name"},
 			},
 			"zone": zoneSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the os can be installed",
+			},
+			"ssh":              baremetalOSFieldSchema("SSH keys"),
+			"user":             baremetalOSFieldSchema("user"),
+			"password":         baremetalOSFieldSchema("password"),
+			"service_user":     baremetalOSFieldSchema("service user"),
+			"service_password": baremetalOSFieldSchema("service password"),
 		},
 	}
 }
@@ -44,20 +54,16 @@ func dataSourceScalewayBaremetalOsRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	var osVersion, osName string
-	osID, ok := d.GetOk("os_id")
-	if ok {
+	var os *baremetal.OS
+	if osID, ok := d.GetOk("os_id"); ok {
 		// We fetch the name and version using the os id
-		osID = d.Get("os_id")
-		res, err := api.GetOS(&baremetal.GetOSRequest{
+		os, err = api.GetOS(&baremetal.GetOSRequest{
 			Zone: zone,
-			OsID: osID.(string),
+			OsID: expandID(osID),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		osVersion = res.Version
-		osName = res.Name
 	} else {
 		// Get server by zone and name.
 		res, err := api.ListOS(&baremetal.ListOSRequest{
@@ -66,24 +72,30 @@ func dataSourceScalewayBaremetalOsRead(ctx context.Context, d *schema.ResourceDa
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		if len(res.Os) == 0 {
-			return diag.FromErr(fmt.Errorf("no os found with the name %s", d.Get("name")))
-		}
-		for _, os := range res.Os {
-			if os.Name == d.Get("name") && os.Version == d.Get("version") {
-				osID, osVersion, osName = os.ID, os.Version, os.Name
+		for _, o := range res.Os {
+			if o.Name == d.Get("name") && o.Version == d.Get("version") {
+				os = o
 				break
 			}
 		}
+		if os == nil {
+			return diag.FromErr(fmt.Errorf("no os found with the name %s and version %s in zone %s", d.Get("name"), d.Get("version"), zone))
+		}
 	}
 
-	zoneID := datasourceNewZonedID(osID, zone)
+	zoneID := datasourceNewZonedID(os.ID, zone)
 	d.SetId(zoneID)
 
 	_ = d.Set("os_id", zoneID)
 	_ = d.Set("zone", zone)
-	_ = d.Set("name", osName)
-	_ = d.Set("version", osVersion)
+	_ = d.Set("name", os.Name)
+	_ = d.Set("version", os.Version)
+	_ = d.Set("enabled", os.Enabled)
+	_ = d.Set("ssh", flattenBaremetalOSField(os.SSH))
+	_ = d.Set("user", flattenBaremetalOSField(os.User))
+	_ = d.Set("password", flattenBaremetalOSField(os.Password))
+	_ = d.Set("service_user", flattenBaremetalOSField(os.ServiceUser))
+	_ = d.Set("service_password", flattenBaremetalOSField(os.ServicePassword))
 
 	return nil
 }
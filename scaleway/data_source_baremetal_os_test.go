@@ -40,6 +40,8 @@ func TestAccScalewayDataSourceBaremetalOS_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.scaleway_baremetal_os.by_id", "name"),
 					resource.TestCheckResourceAttrSet("data.scaleway_baremetal_os.by_id", "version"),
 					resource.TestCheckResourceAttrSet("data.scaleway_baremetal_os.by_id", "os_id"),
+					resource.TestCheckResourceAttr("data.scaleway_baremetal_os.by_id", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.scaleway_baremetal_os.by_id", "ssh.#", "1"),
 				),
 			},
 		},
@@ -0,0 +1,51 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	container "github.com/scaleway/scaleway-sdk-go/api/container/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayContainerLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayContainerLogsRead,
+		Schema:      serverlessLogsDataSourceSchema("container", maxContainerLogsLimit),
+	}
+}
+
+func dataSourceScalewayContainerLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, region, err := containerAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	api, region, id, err := containerAPIWithRegionAndID(meta, datasourceNewRegionalizedID(d.Get("container_id"), region))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listLogs := func() ([]serverlessLog, error) {
+		res, err := api.ListLogs(&container.ListLogsRequest{
+			Region:      region,
+			ContainerID: id,
+			PageSize:    scw.Uint32Ptr(uint32(d.Get("limit").(int))),
+			OrderBy:     container.ListLogsRequestOrderByTimestampDesc,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return expandContainerServerlessLogs(res.Logs), nil
+	}
+	get := func() error {
+		_, err := api.GetContainer(&container.GetContainerRequest{
+			Region:      region,
+			ContainerID: id,
+		}, scw.WithContext(ctx))
+		return err
+	}
+
+	return readServerlessLogs(d, "container", region, id, listLogs, get)
+}
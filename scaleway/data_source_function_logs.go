@@ -0,0 +1,51 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayFunctionLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayFunctionLogsRead,
+		Schema:      serverlessLogsDataSourceSchema("function", maxFunctionLogsLimit),
+	}
+}
+
+func dataSourceScalewayFunctionLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, region, err := functionAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	api, region, id, err := functionAPIWithRegionAndID(meta, datasourceNewRegionalizedID(d.Get("function_id"), region))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listLogs := func() ([]serverlessLog, error) {
+		res, err := api.ListLogs(&function.ListLogsRequest{
+			Region:     region,
+			FunctionID: id,
+			PageSize:   scw.Uint32Ptr(uint32(d.Get("limit").(int))),
+			OrderBy:    function.ListLogsRequestOrderByTimestampDesc,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return expandFunctionServerlessLogs(res.Logs), nil
+	}
+	get := func() error {
+		_, err := api.GetFunction(&function.GetFunctionRequest{
+			Region:     region,
+			FunctionID: id,
+		}, scw.WithContext(ctx))
+		return err
+	}
+
+	return readServerlessLogs(d, "function", region, id, listLogs, get)
+}
@@ -0,0 +1,120 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceServerTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceServerTypesRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return the server type with this name",
+				DiffSuppressFunc: diffSuppressFuncIgnoreCase,
+			},
+			"server_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server types offered in the zone, sorted by name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the server type",
+						},
+						"cpus": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of CPUs",
+						},
+						"gpus": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of GPUs",
+						},
+						"ram": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The available RAM in bytes",
+						},
+						"arch": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CPU architecture",
+						},
+						"hourly_price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The hourly price in Euro",
+						},
+						"monthly_price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The estimated monthly price, for a 30 days month, in Euro",
+						},
+						"volume_types": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The volume types that can be attached to the server type",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"local_volume_min_size_in_gb": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The minimum total size of the local volumes, in GB",
+						},
+						"local_volume_max_size_in_gb": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum total size of the local volumes, in GB",
+						},
+						"availability": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The stock status of the server type in the zone: available, scarce or shortage",
+						},
+					},
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayInstanceServerTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverTypes, err := instanceAPI.ListServersTypes(&instance.ListServersTypesRequest{
+		Zone: zone,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	availabilities, err := instanceAPI.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
+		Zone:    zone,
+		PerPage: scw.Uint32Ptr(100),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("server_types", flattenInstanceServerTypes(serverTypes.Servers, availabilities.Servers, d.Get("name").(string)))
+	_ = d.Set("zone", zone.String())
+
+	return nil
+}
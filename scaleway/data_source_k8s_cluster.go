@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +18,8 @@ func dataSourceScalewayK8SCluster() *schema.Resource {
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "region")
 	delete(dsSchema, "delete_additional_resources")
+	delete(dsSchema, "delete_pools_before_cluster")
+	dsSchema["kubeconfig"].Sensitive = true
 
 	dsSchema["name"].ConflictsWith = []string{"cluster_id"}
 	dsSchema["cluster_id"] = &schema.Schema{
@@ -26,6 +29,12 @@ func dataSourceScalewayK8SCluster() *schema.Resource {
 		ValidateFunc:  validationUUIDorUUIDWithLocality(),
 		ConflictsWith: []string{"name"},
 	}
+	dsSchema["pool_ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The IDs of the pools of the cluster",
+	}
 
 	return &schema.Resource{
 		ReadContext: dataSourceScalewayK8SClusterRead,
@@ -46,25 +55,41 @@ func dataSourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceDat
 			Region:    region,
 			Name:      expandStringPtr(d.Get("name")),
 			ProjectID: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		for _, cluster := range res.Clusters {
-			if cluster.Name == d.Get("name").(string) {
-				if clusterID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 cluster found with the same name %s", d.Get("name")))
-				}
-				clusterID = cluster.ID
-			}
+		clusterIDs := k8sClusterIDsWithName(res.Clusters, d.Get("name").(string))
+		if len(clusterIDs) > 1 {
+			return diag.FromErr(fmt.Errorf("%d clusters found with the same name %s: %s", len(clusterIDs), d.Get("name"), strings.Join(clusterIDs, ", ")))
 		}
-		if clusterID == "" {
+		if len(clusterIDs) == 0 {
 			return diag.FromErr(fmt.Errorf("no cluster found with the name %s", d.Get("name")))
 		}
+		clusterID = clusterIDs[0]
 	}
 
 	regionalizedID := datasourceNewRegionalizedID(clusterID, region)
 	d.SetId(regionalizedID)
 	_ = d.Set("cluster_id", regionalizedID)
-	return resourceScalewayK8SClusterRead(ctx, d, meta)
+
+	diags := resourceScalewayK8SClusterRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	pools, err := k8sAPI.ListPools(&k8s.ListPoolsRequest{
+		Region:    region,
+		ClusterID: expandID(clusterID),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	poolIDs := make([]string, 0, len(pools.Pools))
+	for _, pool := range pools.Pools {
+		poolIDs = append(poolIDs, newRegionalIDString(region, pool.ID))
+	}
+	_ = d.Set("pool_ids", poolIDs)
+
+	return diags
 }
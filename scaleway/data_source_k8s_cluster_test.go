@@ -47,6 +47,10 @@ func TestAccScalewayDataSourceK8SCluster_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.scaleway_k8s_cluster.prod", "name", clusterName),
 					testAccCheckScalewayK8SClusterExists(tt, "data.scaleway_k8s_cluster.stg"),
 					resource.TestCheckResourceAttr("data.scaleway_k8s_cluster.stg", "name", clusterName),
+					resource.TestCheckResourceAttr("data.scaleway_k8s_cluster.stg", "pool_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_k8s_cluster.stg", "pool_ids.0", "scaleway_k8s_pool.default", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_k8s_cluster.prod", "apiserver_url", "scaleway_k8s_cluster.main", "apiserver_url"),
+					resource.TestCheckResourceAttrSet("data.scaleway_k8s_cluster.prod", "kubeconfig.0.config_file"),
 				),
 			},
 		},
@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -31,6 +32,21 @@ func dataSourceScalewayLb() *schema.Resource {
 		Default:     false,
 		Description: "Release the IPs related to this load-balancer",
 	}
+	dsSchema["status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The status of the load-balancer",
+	}
+	dsSchema["frontend_count"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of frontends of the load-balancer",
+	}
+	dsSchema["backend_count"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of backends of the load-balancer",
+	}
 
 	return &schema.Resource{
 		ReadContext: dataSourceScalewayLbRead,
@@ -54,13 +70,14 @@ func dataSourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		if len(res.LBs) == 0 {
-			return diag.FromErr(fmt.Errorf("no lbs found with the name %s", d.Get("name")))
+		lbIDs := lbIDsWithName(res.LBs, d.Get("name").(string))
+		if len(lbIDs) > 1 {
+			return diag.FromErr(fmt.Errorf("%d lbs found with the same name %s: %s", len(lbIDs), d.Get("name"), strings.Join(lbIDs, ", ")))
 		}
-		if len(res.LBs) > 1 {
-			return diag.FromErr(fmt.Errorf("%d lbs found with the same name %s", len(res.LBs), d.Get("name")))
+		if len(lbIDs) == 0 {
+			return diag.FromErr(fmt.Errorf("no lbs found with the name %s", d.Get("name")))
 		}
-		lbID = res.LBs[0].ID
+		lbID = lbIDs[0]
 	}
 
 	err = d.Set("release_ip", false)
@@ -73,5 +90,17 @@ func dataSourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	return resourceScalewayLbRead(ctx, d, meta)
+	lb, diags := readScalewayLb(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	if lb == nil {
+		return diag.Errorf("LB not found (%s)", zonedID)
+	}
+
+	_ = d.Set("status", lb.Status.String())
+	_ = d.Set("frontend_count", lb.FrontendCount)
+	_ = d.Set("backend_count", lb.BackendCount)
+
+	return diags
 }
@@ -0,0 +1,94 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayLbBackendServerHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayLbBackendServerHealthRead,
+		Schema: map[string]*schema.Schema{
+			"backend_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the backend",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The health of the backend servers, sorted by IP",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP of the backend server",
+						},
+						"server_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The operational state of the backend server",
+						},
+						"server_state_changed_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date of the last operational state change",
+						},
+						"last_health_check_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The result of the last health check of the backend server",
+						},
+					},
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayLbBackendServerHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, zone, err := lbAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backendID := expandID(d.Get("backend_id"))
+
+	backend, err := api.GetBackend(&lbSDK.ZonedAPIGetBackendRequest{
+		Zone:      zone,
+		BackendID: backendID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Stats are listed per load balancer, only the ones of the backend are kept
+	res, err := api.ListBackendStats(&lbSDK.ZonedAPIListBackendStatsRequest{
+		Zone: zone,
+		LBID: backend.LB.ID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stats := []*lbSDK.BackendServerStats(nil)
+	for _, stat := range res.BackendServersStats {
+		if stat.BackendID == backendID {
+			stats = append(stats, stat)
+		}
+	}
+
+	id := newZonedIDString(zone, backendID)
+	d.SetId(id)
+	_ = d.Set("backend_id", id)
+	_ = d.Set("servers", flattenLbBackendServersStats(stats))
+	_ = d.Set("zone", zone.String())
+
+	return nil
+}
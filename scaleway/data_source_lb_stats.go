@@ -0,0 +1,116 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayLbStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayLbStatsRead,
+		Schema: map[string]*schema.Schema{
+			"lb_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the load balancer",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"servers_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of backend servers of the load balancer",
+			},
+			"healthy_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of backend servers whose last health check passed",
+			},
+			"unhealthy_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of backend servers whose last health check failed",
+			},
+			"unknown_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of backend servers not checked yet or without a conclusive health check",
+			},
+			"backends": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The health counts of each backend, sorted by backend ID",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backend_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the backend",
+						},
+						"servers_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of servers of the backend",
+						},
+						"healthy_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of servers whose last health check passed",
+						},
+						"unhealthy_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of servers whose last health check failed",
+						},
+						"unknown_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of servers not checked yet or without a conclusive health check",
+						},
+					},
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayLbStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, zone, err := lbAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	lbID := expandID(d.Get("lb_id"))
+
+	res, err := api.ListBackendStats(&lbSDK.ZonedAPIListBackendStatsRequest{
+		Zone: zone,
+		LBID: lbID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backends := flattenLbBackendsHealthCounts(res.BackendServersStats)
+	totals := map[string]int{}
+	for _, backend := range backends {
+		backend["backend_id"] = newZonedIDString(zone, backend["backend_id"].(string))
+		for _, key := range []string{"servers_count", "healthy_count", "unhealthy_count", "unknown_count"} {
+			totals[key] += backend[key].(int)
+		}
+	}
+
+	id := newZonedIDString(zone, lbID)
+	d.SetId(id)
+	_ = d.Set("lb_id", id)
+	_ = d.Set("servers_count", totals["servers_count"])
+	_ = d.Set("healthy_count", totals["healthy_count"])
+	_ = d.Set("unhealthy_count", totals["unhealthy_count"])
+	_ = d.Set("unknown_count", totals["unknown_count"])
+	_ = d.Set("backends", backends)
+	_ = d.Set("zone", zone.String())
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 )
 
 func TestAccScalewayDataSourceLb_Basic(t *testing.T) {
@@ -42,6 +43,16 @@ func TestAccScalewayDataSourceLb_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(
 						"data.scaleway_lb.testByName", "id",
 						"scaleway_lb.main", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.scaleway_lb.testByName", "ip_id",
+						"scaleway_lb_ip.main", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.scaleway_lb.testByID", "ip_address",
+						"scaleway_lb_ip.main", "ip_address"),
+					resource.TestCheckResourceAttr("data.scaleway_lb.testByID", "type", "LB-S"),
+					resource.TestCheckResourceAttr("data.scaleway_lb.testByID", "status", lbSDK.LBStatusReady.String()),
+					resource.TestCheckResourceAttr("data.scaleway_lb.testByID", "frontend_count", "0"),
+					resource.TestCheckResourceAttr("data.scaleway_lb.testByID", "backend_count", "0"),
 				),
 			},
 		},
@@ -10,8 +10,18 @@ const (
 	ErrCodeNoSuchCORSConfiguration = "NoSuchCORSConfiguration"
 	// ErrCodeNoSuchLifecycleConfiguration lifecycle configuration rule not found
 	ErrCodeNoSuchLifecycleConfiguration = "NoSuchLifecycleConfiguration"
+	// ErrCodeReplicationConfigurationNotFound replication configuration not found
+	ErrCodeReplicationConfigurationNotFound = "ReplicationConfigurationNotFoundError"
 	// ErrCodeAccessDenied action on resource is denied
 	ErrCodeAccessDenied = "AccessDenied"
 	// ErrCodeBucketNotEmpty bucket is not empty
 	ErrCodeBucketNotEmpty = "BucketNotEmpty"
+	// ErrCodeNotImplemented feature is not supported by the endpoint
+	ErrCodeNotImplemented = "NotImplemented"
+	// ErrCodeNoSuchPublicAccessBlockConfiguration public access block configuration not found
+	ErrCodeNoSuchPublicAccessBlockConfiguration = "NoSuchPublicAccessBlockConfiguration"
+	// ErrCodeOwnershipControlsNotFound ownership controls not found
+	ErrCodeOwnershipControlsNotFound = "OwnershipControlsNotFoundError"
+	// ErrCodeNoSuchWebsiteConfiguration website configuration not found
+	ErrCodeNoSuchWebsiteConfiguration = "NoSuchWebsiteConfiguration"
 )
@@ -1,6 +1,7 @@
 package scaleway
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -162,6 +163,38 @@ func newRegionalIDString(region scw.Region, id string) string {
 	return fmt.Sprintf("%s/%s", region, id)
 }
 
+// importStateRegionalID is a StateContextFunc accepting either a regional ID or a bare ID,
+// in which case the region of the provider is used.
+func importStateRegionalID(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseRegionalID(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	region, err := extractRegion(d, m.(*Meta))
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(newRegionalIDString(region, d.Id()))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importStateZonedID is a StateContextFunc accepting either a zoned ID or a bare ID,
+// in which case the zone of the provider is used.
+func importStateZonedID(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseZonedID(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	zone, err := extractZone(d, m.(*Meta))
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(newZonedIDString(zone, d.Id()))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // terraformResourceData is an interface for *schema.ResourceData. (used for mock)
 type terraformResourceData interface {
 	HasChange(string) bool
@@ -476,6 +509,18 @@ func expandStringPtr(data interface{}) *string {
 	return scw.StringPtr(data.(string))
 }
 
+func expandTimePtr(i interface{}) *time.Time {
+	rawTime := expandStringPtr(i)
+	if rawTime == nil {
+		return nil
+	}
+	parsedTime, err := time.Parse(time.RFC3339, *rawTime)
+	if err != nil {
+		return nil
+	}
+	return &parsedTime
+}
+
 func expandBoolPtr(data interface{}) *bool {
 	if data == nil {
 		return nil
@@ -497,6 +542,13 @@ func expandInt32Ptr(data interface{}) *int32 {
 	return scw.Int32Ptr(int32(data.(int)))
 }
 
+func expandUint32Ptr(data interface{}) *uint32 {
+	if data == nil || data == "" {
+		return nil
+	}
+	return scw.Uint32Ptr(uint32(data.(int)))
+}
+
 func expandIPNet(raw string) (scw.IPNet, error) {
 	if raw == "" {
 		return scw.IPNet{}, nil
@@ -555,6 +607,18 @@ func diffSuppressFuncDuration(k, old, new string, d *schema.ResourceData) bool {
 	return d1 == d2
 }
 
+func diffSuppressFuncTimeRFC3339(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	t1, err1 := time.Parse(time.RFC3339, old)
+	t2, err2 := time.Parse(time.RFC3339, new)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return t1.Equal(t2)
+}
+
 func diffSuppressFuncIgnoreCase(k, old, new string, d *schema.ResourceData) bool {
 	return strings.EqualFold(old, new)
 }
@@ -565,6 +629,24 @@ func diffSuppressFuncIgnoreCaseAndHyphen(k, old, new string, d *schema.ResourceD
 
 // diffSuppressFuncLocality is a SuppressDiffFunc to remove the locality from an ID when checking diff.
 // e.g. 2c1a1716-5570-4668-a50a-860c90beabf6 == fr-par-1/2c1a1716-5570-4668-a50a-860c90beabf6
+// diffSuppressFuncIPNet suppresses the diff between two notations of the same IP network,
+// e.g. "10.0.0.1" and "10.0.0.1/32", or "10.0.0.1/24" and "10.0.0.0/24".
+func diffSuppressFuncIPNet(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	oldNet, err := expandIPNet(oldValue)
+	if err != nil {
+		return false
+	}
+	newNet, err := expandIPNet(newValue)
+	if err != nil {
+		return false
+	}
+	oldOnes, oldBits := oldNet.Mask.Size()
+	newOnes, newBits := newNet.Mask.Size()
+
+	return oldOnes == newOnes && oldBits == newBits &&
+		oldNet.IP.Mask(oldNet.Mask).Equal(newNet.IP.Mask(newNet.Mask))
+}
+
 func diffSuppressFuncLocality(k, old, new string, d *schema.ResourceData) bool {
 	return expandID(old) == expandID(new)
 }
@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -86,6 +87,45 @@ func flattenBaremetalMemory(memories []*baremetal.Memory) interface{} {
 	return flattenedMemories
 }
 
+// baremetalOSFieldSchema describes an installation requirement of a baremetal os
+func baremetalOSFieldSchema(field string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: fmt.Sprintf("Requirements on the %s when installing the os", field),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"editable": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: fmt.Sprintf("True if the %s can be set at installation", field),
+				},
+				"required": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: fmt.Sprintf("True if the %s must be set at installation", field),
+				},
+				"default_value": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: fmt.Sprintf("Default value of the %s", field),
+				},
+			},
+		},
+	}
+}
+
+func flattenBaremetalOSField(field *baremetal.OSOSField) interface{} {
+	if field == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		"editable":      field.Editable,
+		"required":      field.Required,
+		"default_value": flattenStringPtr(field.DefaultValue),
+	}}
+}
+
 func flattenBaremetalIPs(ips []*baremetal.IP) interface{} {
 	if ips == nil {
 		return nil
@@ -2,16 +2,28 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	container "github.com/scaleway/scaleway-sdk-go/api/container/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 const (
 	defaultContainerNamespaceTimeout = 5 * time.Minute
+	defaultContainerTimeout          = 15 * time.Minute
 	defaultContainerRetryInterval    = 5 * time.Second
+	defaultContainerLogsLimit        = 10
+	maxContainerLogsLimit            = 100
+
+	// The SDK has no enum for the http_option of a container
+	containerHTTPOptionEnabled    = "enabled"
+	containerHTTPOptionRedirected = "redirected"
 )
 
 // containerAPIWithRegion returns a new container API and the region.
@@ -53,6 +65,7 @@ func setCreateContainerRequest(d *schema.ResourceData, region scw.Region) (*cont
 		Name:        name,
 		Privacy:     container.ContainerPrivacy(privacyType.(string)),
 		Protocol:    container.ContainerProtocol(*expandStringPtr(protocol)),
+		HTTPOption:  expandStringPtr(d.Get("http_option")),
 	}
 
 	// optional
@@ -115,3 +128,252 @@ func waitForContainerNamespace(ctx context.Context, containerAPI *container.API,
 
 	return ns, err
 }
+
+// waitForContainer waits for the container to reach a terminal status.
+// If the container ends up in error, it is returned along with an error containing its last logs.
+func waitForContainer(ctx context.Context, containerAPI *container.API, region scw.Region, id string, timeout time.Duration) (*container.Container, error) {
+	retryInterval := defaultContainerRetryInterval
+	if DefaultWaitRetryInterval != nil {
+		retryInterval = *DefaultWaitRetryInterval
+	}
+
+	co, err := containerAPI.WaitForContainer(&container.WaitForContainerRequest{
+		Region:        region,
+		ContainerID:   id,
+		RetryInterval: &retryInterval,
+		Timeout:       scw.TimeDurationPtr(timeout),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if co.Status == container.ContainerStatusError {
+		return co, fmt.Errorf("container %s is in %s status: %s%s", co.ID, co.Status, flattenStringPtr(co.ErrorMessage), containerLastLogs(ctx, containerAPI, region, id))
+	}
+
+	return co, nil
+}
+
+// containerLastLogs returns the last logs of a container formatted to be appended to an error.
+// Logs are best effort: an empty string is returned if they cannot be fetched.
+func containerLastLogs(ctx context.Context, containerAPI *container.API, region scw.Region, id string) string {
+	res, err := containerAPI.ListLogs(&container.ListLogsRequest{
+		Region:      region,
+		ContainerID: id,
+		PageSize:    scw.Uint32Ptr(defaultContainerLogsLimit),
+		OrderBy:     container.ListLogsRequestOrderByTimestampDesc,
+	}, scw.WithContext(ctx))
+	if err != nil || len(res.Logs) == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(res.Logs))
+	for i := len(res.Logs) - 1; i >= 0; i-- {
+		lines = append(lines, fmt.Sprintf("%s %s", flattenTime(res.Logs[i].Timestamp), res.Logs[i].Message))
+	}
+
+	return "\nlast logs:\n" + strings.Join(lines, "\n")
+}
+
+// serverlessLog is a log entry of a container or of a function, each API having its own log type.
+type serverlessLog struct {
+	ID        string
+	Timestamp *time.Time
+	Message   string
+}
+
+// serverlessLogsDataSourceSchema returns the schema of the logs data source of a kind of resource, container or function.
+func serverlessLogsDataSourceSchema(kind string, maxLimit int) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		kind + "_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  fmt.Sprintf("The ID of the %s", kind),
+			ValidateFunc: validationUUIDorUUIDWithLocality(),
+		},
+		"limit": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      maxLimit,
+			Description:  "The maximum number of log entries, the most recent ones are kept",
+			ValidateFunc: validation.IntBetween(1, maxLimit),
+		},
+		"logs": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("The most recent log entries of the %s, in chronological order", kind),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the log entry",
+					},
+					"timestamp": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The date of the log entry",
+					},
+					"message": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The message of the log entry",
+					},
+				},
+			},
+		},
+		"region": regionSchema(),
+	}
+}
+
+// readServerlessLogs sets the logs data source of a kind of resource, container or function.
+// The logs endpoint returns a not found error when no logs are available yet, e.g. right after a deployment:
+// the logs are then empty as long as get confirms that the resource exists.
+func readServerlessLogs(d *schema.ResourceData, kind string, region scw.Region, id string, listLogs func() ([]serverlessLog, error), get func() error) diag.Diagnostics {
+	regionalID := newRegionalIDString(region, id)
+
+	logs, err := listLogs()
+	if is404Error(err) {
+		if errGet := get(); errGet != nil {
+			if is404Error(errGet) {
+				return diag.Errorf("%s %s not found", kind, regionalID)
+			}
+			return diag.FromErr(errGet)
+		}
+		logs, err = nil, nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(regionalID)
+	_ = d.Set(kind+"_id", regionalID)
+	_ = d.Set("logs", flattenServerlessLogs(logs))
+	_ = d.Set("region", region.String())
+
+	return nil
+}
+
+// flattenServerlessLogs returns the logs in chronological order, the APIs listing the most recent first.
+func flattenServerlessLogs(logs []serverlessLog) []map[string]interface{} {
+	flat := make([]map[string]interface{}, 0, len(logs))
+	for i := len(logs) - 1; i >= 0; i-- {
+		flat = append(flat, map[string]interface{}{
+			"id":        logs[i].ID,
+			"timestamp": flattenTime(logs[i].Timestamp),
+			"message":   logs[i].Message,
+		})
+	}
+
+	return flat
+}
+
+// expandContainerServerlessLogs converts the logs of a container to serverless logs.
+func expandContainerServerlessLogs(logs []*container.Log) []serverlessLog {
+	res := make([]serverlessLog, 0, len(logs))
+	for _, log := range logs {
+		res = append(res, serverlessLog{ID: log.ID, Timestamp: log.Timestamp, Message: log.Message})
+	}
+	return res
+}
+
+// flattenContainerLogs returns the logs of a container in chronological order, the API listing the most recent first.
+func flattenContainerLogs(logs []*container.Log) []map[string]interface{} {
+	return flattenServerlessLogs(expandContainerServerlessLogs(logs))
+}
+
+// deleteContainerNamespaceResources deletes the containers of a namespace, along with their crons and domains,
+// and waits for their deletion. The containers that could not be deleted are listed in the returned error.
+func deleteContainerNamespaceResources(ctx context.Context, containerAPI *container.API, region scw.Region, namespaceID string, timeout time.Duration) error {
+	res, err := containerAPI.ListContainers(&container.ListContainersRequest{
+		Region:      region,
+		NamespaceID: namespaceID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	blocking := map[string]error{}
+	for _, co := range res.Containers {
+		err := deleteContainerWithResources(ctx, containerAPI, region, co.ID, timeout)
+		if err != nil {
+			blocking[fmt.Sprintf("%s (%s)", co.Name, co.ID)] = err
+		}
+	}
+
+	return namespaceBlockingResourcesError(namespaceID, "containers", blocking)
+}
+
+func deleteContainerWithResources(ctx context.Context, containerAPI *container.API, region scw.Region, id string, timeout time.Duration) error {
+	crons, err := containerAPI.ListCrons(&container.ListCronsRequest{
+		Region:      region,
+		ContainerID: id,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	for _, cron := range crons.Crons {
+		_, err := containerAPI.DeleteCron(&container.DeleteCronRequest{
+			Region: region,
+			CronID: cron.ID,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return fmt.Errorf("failed to delete cron %s: %s", cron.ID, err)
+		}
+	}
+
+	domains, err := containerAPI.ListDomains(&container.ListDomainsRequest{
+		Region:      region,
+		ContainerID: id,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	for _, domain := range domains.Domains {
+		_, err := containerAPI.DeleteDomain(&container.DeleteDomainRequest{
+			Region:   region,
+			DomainID: domain.ID,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return fmt.Errorf("failed to delete domain %s: %s", domain.Hostname, err)
+		}
+	}
+
+	_, err = containerAPI.DeleteContainer(&container.DeleteContainerRequest{
+		Region:      region,
+		ContainerID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = waitForContainer(ctx, containerAPI, region, id, timeout)
+	if err != nil && !is404Error(err) {
+		return err
+	}
+
+	return nil
+}
+
+// namespaceBlockingResourcesError returns an error listing the resources blocking the deletion of a namespace, sorted by name.
+func namespaceBlockingResourcesError(namespaceID string, kind string, blocking map[string]error) error {
+	if len(blocking) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(blocking))
+	for name := range blocking {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	details := make([]string, 0, len(names))
+	for _, name := range names {
+		details = append(details, fmt.Sprintf("%s: %s", name, blocking[name]))
+	}
+
+	return fmt.Errorf("namespace %s cannot be deleted, these %s could not be deleted: %s", namespaceID, kind, strings.Join(details, "; "))
+}
@@ -0,0 +1,36 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	container "github.com/scaleway/scaleway-sdk-go/api/container/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenContainerLogs(t *testing.T) {
+	first := time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Minute)
+
+	logs := flattenContainerLogs([]*container.Log{
+		{ID: "2", Message: "listening on 8080", Timestamp: &second},
+		{ID: "1", Message: "starting", Timestamp: &first},
+	})
+	assert.Equal(t, []map[string]interface{}{
+		{"id": "1", "timestamp": "2022-05-01T10:00:00Z", "message": "starting"},
+		{"id": "2", "timestamp": "2022-05-01T10:01:00Z", "message": "listening on 8080"},
+	}, logs)
+
+	assert.Empty(t, flattenContainerLogs(nil))
+}
+
+func TestNamespaceBlockingResourcesError(t *testing.T) {
+	assert.NoError(t, namespaceBlockingResourcesError("ns", "containers", nil))
+
+	err := namespaceBlockingResourcesError("ns", "containers", map[string]error{
+		"web (2)": fmt.Errorf("timeout"),
+		"api (1)": fmt.Errorf("forbidden"),
+	})
+	assert.EqualError(t, err, "namespace ns cannot be deleted, these containers could not be deleted: api (1): forbidden; web (2): timeout")
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -56,6 +57,31 @@ func getRecordFromData(data string, records []*domain.Record) (*domain.Record, e
 	return currentRecord, nil
 }
 
+// findDomainRecordToImport returns the only record with the given type and name.
+// The data is required to pick one record when several records share the same type and name.
+func findDomainRecordToImport(records []*domain.Record, recordType domain.RecordType, name string, data string) (*domain.Record, error) {
+	var matchingRecords []*domain.Record
+	for _, r := range records {
+		// The name filter of the API is not an exact match
+		if r.Type == recordType && r.Name == name {
+			matchingRecords = append(matchingRecords, r)
+		}
+	}
+
+	if data != "" {
+		return getRecordFromData(data, matchingRecords)
+	}
+
+	switch len(matchingRecords) {
+	case 0:
+		return nil, fmt.Errorf("no %s record named %q found", recordType, name)
+	case 1:
+		return matchingRecords[0], nil
+	}
+
+	return nil, fmt.Errorf("%d %s records named %q found, add the data of the record to import to the ID: {dns_zone}/{type}/{name}/{data}", len(matchingRecords), recordType, name)
+}
+
 func flattenDomainGeoIP(config *domain.RecordGeoIPConfig) interface{} {
 	flattened := []map[string]interface{}{}
 
@@ -261,6 +287,36 @@ func expandDomainView(i interface{}, ok bool) *domain.RecordViewConfig {
 	}
 }
 
+// domainZoneName returns the full name of a DNS zone, which is the ID of scaleway_domain_zone
+func domainZoneName(zone *domain.DNSZone) string {
+	if zone.Subdomain == "" {
+		return zone.Domain
+	}
+	return fmt.Sprintf("%s.%s", zone.Subdomain, zone.Domain)
+}
+
+// domainZoneStatusDiagnostics warns when a DNS zone is not active yet, e.g. while the delegation of a subzone is not propagated
+func domainZoneStatusDiagnostics(zone *domain.DNSZone) diag.Diagnostics {
+	switch zone.Status {
+	case domain.DNSZoneStatusActive, domain.DNSZoneStatusLocked:
+		return nil
+	}
+
+	detail := fmt.Sprintf("the DNS zone %s has the status %s", domainZoneName(zone), zone.Status)
+	if zone.Message != nil && *zone.Message != "" {
+		detail += ": " + *zone.Message
+	}
+	if len(zone.Ns) > 0 {
+		detail += fmt.Sprintf(", check that it is delegated to the name servers %s", strings.Join(zone.Ns, ", "))
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("DNS zone %s is not active", domainZoneName(zone)),
+		Detail:   detail,
+	}}
+}
+
 func waitForDNSZone(ctx context.Context, domainAPI *domain.API, dnsZone string, timeout time.Duration) (*domain.DNSZone, error) {
 	retryInterval := defaultDomainZoneRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -0,0 +1,69 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindDomainRecordToImport(t *testing.T) {
+	records := []*domain.Record{
+		{ID: "a", Name: "www", Type: domain.RecordTypeA, Data: "1.2.3.4"},
+		{ID: "b", Name: "www", Type: domain.RecordTypeA, Data: "1.2.3.5"},
+		{ID: "c", Name: "www2", Type: domain.RecordTypeA, Data: "1.2.3.4"},
+		{ID: "d", Name: "", Type: domain.RecordTypeMX, Data: "10 mx.example.com."},
+		{ID: "e", Name: "", Type: domain.RecordTypeTXT, Data: "\"v=spf1 -all\""},
+	}
+
+	record, err := findDomainRecordToImport(records, domain.RecordTypeA, "www2", "")
+	require.NoError(t, err)
+	assert.Equal(t, "c", record.ID)
+
+	record, err = findDomainRecordToImport(records, domain.RecordTypeA, "www", "1.2.3.5")
+	require.NoError(t, err)
+	assert.Equal(t, "b", record.ID)
+
+	record, err = findDomainRecordToImport(records, domain.RecordTypeMX, "", "10 MX.example.com.")
+	require.NoError(t, err)
+	assert.Equal(t, "d", record.ID)
+
+	record, err = findDomainRecordToImport(records, domain.RecordTypeTXT, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "e", record.ID)
+
+	_, err = findDomainRecordToImport(records, domain.RecordTypeA, "www", "")
+	assert.Error(t, err, "several records match, the data is required")
+
+	_, err = findDomainRecordToImport(records, domain.RecordTypeA, "www", "1.2.3.6")
+	assert.Error(t, err)
+
+	_, err = findDomainRecordToImport(records, domain.RecordTypeCNAME, "www", "")
+	assert.Error(t, err)
+}
+
+func TestDomainZoneStatusDiagnostics(t *testing.T) {
+	zone := &domain.DNSZone{
+		Domain:    "example.com",
+		Subdomain: "sub",
+		Ns:        []string{"ns0.dom.scw.cloud", "ns1.dom.scw.cloud"},
+		Status:    domain.DNSZoneStatusActive,
+	}
+	assert.Empty(t, domainZoneStatusDiagnostics(zone))
+
+	zone.Status = domain.DNSZoneStatusPending
+	zone.Message = scw.StringPtr("waiting for the name servers")
+	diags := domainZoneStatusDiagnostics(zone)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "DNS zone sub.example.com is not active", diags[0].Summary)
+	assert.Equal(t, "the DNS zone sub.example.com has the status pending: waiting for the name servers, check that it is delegated to the name servers ns0.dom.scw.cloud, ns1.dom.scw.cloud", diags[0].Detail)
+}
+
+func TestDomainZoneName(t *testing.T) {
+	assert.Equal(t, "sub.example.com", domainZoneName(&domain.DNSZone{Domain: "example.com", Subdomain: "sub"}))
+	assert.Equal(t, "example.com", domainZoneName(&domain.DNSZone{Domain: "example.com"}))
+}
@@ -0,0 +1,55 @@
+package scaleway
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	flexibleip "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const (
+	defaultFlexibleIPTimeout       = 5 * time.Minute
+	defaultFlexibleIPRetryInterval = 5 * time.Second
+)
+
+// flexibleIPAPIWithZone returns a new flexible IP API and the zone for a Create request
+func flexibleIPAPIWithZone(d *schema.ResourceData, m interface{}) (*flexibleip.API, scw.Zone, error) {
+	meta := m.(*Meta)
+	flexibleIPAPI := flexibleip.NewAPI(meta.scwClient)
+
+	zone, err := extractZone(d, meta)
+	if err != nil {
+		return nil, "", err
+	}
+	return flexibleIPAPI, zone, nil
+}
+
+// flexibleIPAPIWithZoneAndID returns a flexible IP API with zone and ID extracted from the state
+func flexibleIPAPIWithZoneAndID(m interface{}, id string) (*flexibleip.API, scw.Zone, string, error) {
+	meta := m.(*Meta)
+	flexibleIPAPI := flexibleip.NewAPI(meta.scwClient)
+
+	zone, ID, err := parseZonedID(id)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return flexibleIPAPI, zone, ID, nil
+}
+
+func waitForFlexibleIP(ctx context.Context, api *flexibleip.API, zone scw.Zone, id string, timeout time.Duration) (*flexibleip.FlexibleIP, error) {
+	retryInterval := defaultFlexibleIPRetryInterval
+	if DefaultWaitRetryInterval != nil {
+		retryInterval = *DefaultWaitRetryInterval
+	}
+
+	fip, err := api.WaitForFlexibleIP(&flexibleip.WaitForFlexibleIPRequest{
+		FipID:         id,
+		Zone:          zone,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: &retryInterval,
+	}, scw.WithContext(ctx))
+
+	return fip, err
+}
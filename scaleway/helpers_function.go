@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +13,7 @@ import (
 const (
 	defaultFunctionNamespaceTimeout = 5 * time.Minute
 	defaultFunctionRetryInterval    = 5 * time.Second
+	maxFunctionLogsLimit            = 100
 )
 
 // functionAPIWithRegion returns a new container registry API and the region.
@@ -53,3 +55,97 @@ func waitForFunctionNamespace(ctx context.Context, functionAPI *function.API, re
 
 	return ns, err
 }
+
+// expandFunctionServerlessLogs converts the logs of a function to serverless logs.
+func expandFunctionServerlessLogs(logs []*function.Log) []serverlessLog {
+	res := make([]serverlessLog, 0, len(logs))
+	for _, log := range logs {
+		res = append(res, serverlessLog{ID: log.ID, Timestamp: log.Timestamp, Message: log.Message})
+	}
+	return res
+}
+
+// deleteFunctionNamespaceResources deletes the functions of a namespace, along with their crons and domains,
+// and waits for their deletion. The functions that could not be deleted are listed in the returned error.
+func deleteFunctionNamespaceResources(ctx context.Context, functionAPI *function.API, region scw.Region, namespaceID string, timeout time.Duration) error {
+	res, err := functionAPI.ListFunctions(&function.ListFunctionsRequest{
+		Region:      region,
+		NamespaceID: namespaceID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	blocking := map[string]error{}
+	for _, fn := range res.Functions {
+		err := deleteFunctionWithResources(ctx, functionAPI, region, fn.ID, timeout)
+		if err != nil {
+			blocking[fmt.Sprintf("%s (%s)", fn.Name, fn.ID)] = err
+		}
+	}
+
+	return namespaceBlockingResourcesError(namespaceID, "functions", blocking)
+}
+
+func deleteFunctionWithResources(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) error {
+	crons, err := functionAPI.ListCrons(&function.ListCronsRequest{
+		Region:     region,
+		FunctionID: id,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	for _, cron := range crons.Crons {
+		_, err := functionAPI.DeleteCron(&function.DeleteCronRequest{
+			Region: region,
+			CronID: cron.ID,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return fmt.Errorf("failed to delete cron %s: %s", cron.ID, err)
+		}
+	}
+
+	domains, err := functionAPI.ListDomains(&function.ListDomainsRequest{
+		Region:     region,
+		FunctionID: id,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	for _, domain := range domains.Domains {
+		_, err := functionAPI.DeleteDomain(&function.DeleteDomainRequest{
+			Region:   region,
+			DomainID: domain.ID,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return fmt.Errorf("failed to delete domain %s: %s", domain.Hostname, err)
+		}
+	}
+
+	_, err = functionAPI.DeleteFunction(&function.DeleteFunctionRequest{
+		Region:     region,
+		FunctionID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return err
+	}
+
+	retryInterval := defaultFunctionRetryInterval
+	if DefaultWaitRetryInterval != nil {
+		retryInterval = *DefaultWaitRetryInterval
+	}
+	_, err = functionAPI.WaitForFunction(&function.WaitForFunctionRequest{
+		Region:        region,
+		FunctionID:    id,
+		RetryInterval: &retryInterval,
+		Timeout:       scw.TimeDurationPtr(timeout),
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return err
+	}
+
+	return nil
+}
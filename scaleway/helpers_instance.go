@@ -1,17 +1,33 @@
 package scaleway
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/mail"
+	"net/textproto"
+	"path/filepath"
 	"sort"
+	"strconv"
+	"strings"
+	"text/template"
 	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v1"
+	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -32,6 +48,16 @@ const (
 	defaultInstanceRetryInterval            = 5 * time.Second
 
 	defaultInstanceSnapshotWaitTimeout = 1 * time.Hour
+	defaultInstanceImageTimeout        = 1 * time.Hour
+
+	// instanceServerUserDataMergeReplace forbids setting the cloud-init user data from several sources
+	instanceServerUserDataMergeReplace = "replace"
+	// instanceServerUserDataMergeMerge combines the cloud-init user data of every source in a MIME multipart document
+	instanceServerUserDataMergeMerge = "merge"
+
+	cloudInitMergeBoundary = "==SCALEWAY-CLOUD-INIT=="
+	// cloudInitMergeType makes cloud-init merge the cloud-config parts, instead of the last part replacing the keys of the previous ones
+	cloudInitMergeType = "list(append)+dict(recurse_array)+str()"
 )
 
 // instanceAPIWithZone returns a new instance API and the zone for a Create request
@@ -204,6 +230,100 @@ func getServerType(ctx context.Context, apiInstance *instance.API, zone scw.Zone
 	return serverType
 }
 
+// instanceServerTypeVolumeTypes returns the volume types that can be attached to a server type:
+// block volumes can be attached to any type, local volumes only to types with local storage.
+func instanceServerTypeVolumeTypes(serverType *instance.ServerType) []string {
+	volumeTypes := []string{instance.VolumeVolumeTypeBSSD.String()}
+	if serverType.VolumesConstraint != nil && serverType.VolumesConstraint.MaxSize > 0 {
+		volumeTypes = append(volumeTypes, instance.VolumeVolumeTypeLSSD.String())
+	}
+	return volumeTypes
+}
+
+// flattenInstanceServerTypes flattens the server types sorted by name, along with their availability.
+// Only the server type named name is kept when it is set.
+func flattenInstanceServerTypes(serverTypes map[string]*instance.ServerType, availabilities map[string]*instance.GetServerTypesAvailabilityResponseAvailability, name string) []map[string]interface{} {
+	names := make([]string, 0, len(serverTypes))
+	for serverTypeName := range serverTypes {
+		if name == "" || strings.EqualFold(serverTypeName, name) {
+			names = append(names, serverTypeName)
+		}
+	}
+	sort.Strings(names)
+
+	flattened := make([]map[string]interface{}, 0, len(names))
+	for _, serverTypeName := range names {
+		serverType := serverTypes[serverTypeName]
+
+		gpus := 0
+		if serverType.Gpu != nil {
+			gpus = int(*serverType.Gpu)
+		}
+		var minSize, maxSize scw.Size
+		if serverType.VolumesConstraint != nil {
+			minSize, maxSize = serverType.VolumesConstraint.MinSize, serverType.VolumesConstraint.MaxSize
+		}
+		availability := ""
+		if a, ok := availabilities[serverTypeName]; ok && a != nil {
+			availability = a.Availability.String()
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"name":                        serverTypeName,
+			"cpus":                        int(serverType.Ncpus),
+			"gpus":                        gpus,
+			"ram":                         int(serverType.RAM),
+			"arch":                        serverType.Arch.String(),
+			"hourly_price":                float64(serverType.HourlyPrice),
+			"monthly_price":               float64(serverType.MonthlyPrice),
+			"volume_types":                instanceServerTypeVolumeTypes(serverType),
+			"local_volume_min_size_in_gb": int(uint64(minSize) / gb),
+			"local_volume_max_size_in_gb": int(uint64(maxSize) / gb),
+			"availability":                availability,
+		})
+	}
+
+	return flattened
+}
+
+// validateInstanceServerTypeAvailability checks that commercialType is offered in the zone,
+// given the server types availability of the zone.
+func validateInstanceServerTypeAvailability(availabilities map[string]*instance.GetServerTypesAvailabilityResponseAvailability, commercialType string, zone scw.Zone) error {
+	for serverType := range availabilities {
+		if strings.EqualFold(serverType, commercialType) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("instance type %s is not available in zone %s", commercialType, zone)
+}
+
+// checkInstanceServerTypeAvailability checks that commercialType is offered in the zone.
+// The check is best-effort: a warning is returned when the availability cannot be fetched.
+func checkInstanceServerTypeAvailability(ctx context.Context, apiInstance *instance.API, zone scw.Zone, commercialType string) diag.Diagnostics {
+	res, err := apiInstance.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
+		Zone:    zone,
+		PerPage: scw.Uint32Ptr(100),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("cannot check the availability of instance type %s in zone %s", commercialType, zone),
+			Detail:   err.Error(),
+		}}
+	}
+
+	return diag.FromErr(validateInstanceServerTypeAvailability(res.Servers, commercialType, zone))
+}
+
+// instanceServerTypeGPUCount returns the number of GPUs of a server type.
+func instanceServerTypeGPUCount(serverType *instance.ServerType) int {
+	if serverType == nil || serverType.Gpu == nil {
+		return 0
+	}
+	return int(*serverType.Gpu)
+}
+
 // validateLocalVolumeSizes validates the total size of local volumes.
 func validateLocalVolumeSizes(volumes map[string]*instance.VolumeServerTemplate, serverType *instance.ServerType, commercialType string) error {
 	// Calculate local volume total size.
@@ -234,6 +354,60 @@ func validateLocalVolumeSizes(volumes map[string]*instance.VolumeServerTemplate,
 	return nil
 }
 
+// validateServerTypeChange checks that a server can be moved to another commercial type:
+// both types must share the same architecture and the local volumes of the server must fit the new type.
+func validateServerTypeChange(ctx context.Context, apiInstance *instance.API, zone scw.Zone, server *instance.Server, commercialType string) error {
+	serverTypesRes, err := apiInstance.ListServersTypes(&instance.ListServersTypesRequest{
+		Zone: zone,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("cannot get server types: %s", err)
+	}
+
+	serverType := serverTypesRes.Servers[commercialType]
+	if serverType == nil {
+		return fmt.Errorf("could not find a server type associated with %s", commercialType)
+	}
+
+	currentType := serverTypesRes.Servers[server.CommercialType]
+	if currentType != nil && currentType.Arch != serverType.Arch {
+		return fmt.Errorf("cannot change type from %s to %s: %s architecture is not compatible with %s", server.CommercialType, commercialType, serverType.Arch, currentType.Arch)
+	}
+
+	volumes := make(map[string]*instance.VolumeServerTemplate, len(server.Volumes))
+	for key, volume := range server.Volumes {
+		volumes[key] = &instance.VolumeServerTemplate{
+			VolumeType: instance.VolumeVolumeType(volume.VolumeType),
+			Size:       volume.Size,
+		}
+	}
+	if err := validateLocalVolumeSizes(volumes, serverType, commercialType); err != nil {
+		return fmt.Errorf("cannot change type from %s to %s: %s", server.CommercialType, commercialType, err)
+	}
+
+	return nil
+}
+
+// updateServerCommercialType changes the commercial type of a stopped server.
+// The UpdateServerRequest of the pinned scaleway-sdk-go has no commercial_type field while the API accepts it,
+// so the PATCH is built by hand. It should go through UpdateServerRequest once the SDK is bumped to a version exposing it.
+func updateServerCommercialType(ctx context.Context, client *scw.Client, zone scw.Zone, serverID string, commercialType string) error {
+	req := &scw.ScalewayRequest{
+		Method:  "PATCH",
+		Path:    "/instance/v1/zones/" + zone.String() + "/servers/" + serverID,
+		Headers: http.Header{},
+	}
+
+	err := req.SetBody(map[string]string{
+		"commercial_type": commercialType,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.Do(req, &instance.UpdateServerResponse{}, scw.WithContext(ctx))
+}
+
 // sanitizeVolumeMap removes extra data for API validation.
 //
 // On the api side, there are two possibles validation schemas for volumes and the validator will be chosen dynamically depending on the passed JSON request
@@ -412,6 +586,50 @@ func (ph *privateNICsHandler) get(key string) (interface{}, error) {
 	}, nil
 }
 
+// instanceServerPrivateIPs returns the private IP of each private network of a server, in the same order.
+// Addresses are looked up in the DHCP entries of the public gateways by MAC address:
+// a private network without a DHCP gateway, or a NIC without a lease yet, gets an empty IP.
+func instanceServerPrivateIPs(ctx context.Context, api *vpcgw.API, zone scw.Zone, privateNetworks []interface{}) ([]string, error) {
+	ips := make([]string, 0, len(privateNetworks))
+	for _, raw := range privateNetworks {
+		macAddress := raw.(map[string]interface{})["mac_address"].(string)
+		if macAddress == "" {
+			ips = append(ips, "")
+			continue
+		}
+
+		res, err := api.ListDHCPEntries(&vpcgw.ListDHCPEntriesRequest{
+			Zone:       zone,
+			MacAddress: &macAddress,
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		ips = append(ips, dhcpEntriesIP(res.DHCPEntries))
+	}
+
+	return ips, nil
+}
+
+// dhcpEntriesIP returns the IP of the entries of a NIC, a static reservation taking precedence over a lease
+func dhcpEntriesIP(entries []*vpcgw.DHCPEntry) string {
+	ip := ""
+	for _, entry := range entries {
+		if entry.IPAddress == nil {
+			continue
+		}
+		if entry.Type == vpcgw.DHCPEntryTypeReservation {
+			return entry.IPAddress.String()
+		}
+		if ip == "" {
+			ip = entry.IPAddress.String()
+		}
+	}
+
+	return ip
+}
+
 func waitForInstanceSnapshot(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Snapshot, error) {
 	retryInterval := defaultInstanceRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -428,6 +646,22 @@ func waitForInstanceSnapshot(ctx context.Context, api *instance.API, zone scw.Zo
 	return snapshot, err
 }
 
+func waitForInstanceImage(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Image, error) {
+	retryInterval := defaultInstanceRetryInterval
+	if DefaultWaitRetryInterval != nil {
+		retryInterval = *DefaultWaitRetryInterval
+	}
+
+	image, err := api.WaitForImage(&instance.WaitForImageRequest{
+		ImageID:       id,
+		Zone:          zone,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: &retryInterval,
+	}, scw.WithContext(ctx))
+
+	return image, err
+}
+
 func waitForInstanceVolume(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Volume, error) {
 	retryInterval := defaultInstanceRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -443,6 +677,76 @@ func waitForInstanceVolume(ctx context.Context, api *instance.API, zone scw.Zone
 	return volume, err
 }
 
+// createInstanceVolumeFromSnapshot creates a volume from a snapshot and grows it to the given size.
+// The volume type defaults to the one of the snapshot and the size to the size of the snapshot.
+func createInstanceVolumeFromSnapshot(ctx context.Context, api *instance.API, zone scw.Zone, req *instance.CreateVolumeRequest, size scw.Size, timeout time.Duration) (*instance.Volume, error) {
+	snapshot, err := api.GetSnapshot(&instance.GetSnapshotRequest{
+		Zone:       zone,
+		SnapshotID: *req.BaseSnapshot,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.VolumeType == "" {
+		req.VolumeType = snapshot.Snapshot.VolumeType
+	}
+	if size == 0 {
+		size = snapshot.Snapshot.Size
+	}
+	if size < snapshot.Snapshot.Size {
+		return nil, fmt.Errorf("volume size (%s) must be greater or equal to the size of snapshot %s (%s)", humanize.Bytes(uint64(size)), snapshot.Snapshot.ID, humanize.Bytes(uint64(snapshot.Snapshot.Size)))
+	}
+	// Only block volumes can be resized
+	if size > snapshot.Snapshot.Size && req.VolumeType != instance.VolumeVolumeTypeBSSD {
+		return nil, fmt.Errorf("only %s volumes can be larger than their snapshot", instance.VolumeVolumeTypeBSSD)
+	}
+
+	res, err := api.CreateVolume(req, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	volume, err := waitForInstanceVolume(ctx, api, zone, res.Volume.ID, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if size > volume.Size {
+		_, err = api.UpdateVolume(&instance.UpdateVolumeRequest{
+			Zone:     zone,
+			VolumeID: volume.ID,
+			Size:     &size,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		volume, err = waitForInstanceVolume(ctx, api, zone, volume.ID, timeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return volume, nil
+}
+
+// deleteInstanceVolumeFromSnapshot deletes the root volume created from a snapshot when the server could not be created,
+// so that it is not left behind.
+func deleteInstanceVolumeFromSnapshot(ctx context.Context, d *schema.ResourceData, api *instance.API, zone scw.Zone, rootVolume *instance.VolumeServerTemplate) {
+	if _, ok := d.GetOk("root_volume.0.snapshot_id"); !ok || rootVolume == nil || rootVolume.ID == "" {
+		return
+	}
+
+	err := api.DeleteVolume(&instance.DeleteVolumeRequest{
+		Zone:     zone,
+		VolumeID: rootVolume.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("failed to delete root volume %s: %s", rootVolume.ID, err))
+	}
+}
+
 func waitForInstanceServer(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Server, error) {
 	retryInterval := defaultInstanceRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -475,3 +779,262 @@ func waitForPrivateNIC(ctx context.Context, instanceAPI *instance.API, zone scw.
 
 	return nic, err
 }
+
+// validateCloudInit checks that a cloud-init user data is syntactically valid.
+// cloud-config documents are parsed as YAML and MIME multipart documents are split to check
+// each of their cloud-config parts. Other formats (scripts, includes, boothooks...) are not checked.
+func validateCloudInit(cloudInit string) error {
+	switch {
+	case strings.HasPrefix(cloudInit, "#cloud-config"):
+		return validateCloudConfig(cloudInit)
+	case strings.HasPrefix(cloudInit, "Content-Type:") || strings.HasPrefix(cloudInit, "MIME-Version:"):
+		return validateCloudInitMIME(cloudInit)
+	}
+	return nil
+}
+
+func validateCloudConfig(cloudConfig string) error {
+	config := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(cloudConfig), &config); err != nil {
+		return fmt.Errorf("invalid cloud-config: %s", err)
+	}
+	return nil
+}
+
+type instanceServerPlacementRule struct {
+	tag                   string
+	typePrefix            string
+	requirePlacementGroup bool
+	zones                 []scw.Zone
+}
+
+func expandInstanceServerPlacementRules(raw []interface{}) []instanceServerPlacementRule {
+	rules := make([]instanceServerPlacementRule, 0, len(raw))
+	for _, rawRule := range raw {
+		r := rawRule.(map[string]interface{})
+		rule := instanceServerPlacementRule{
+			tag:                   r["tag"].(string),
+			typePrefix:            r["type_prefix"].(string),
+			requirePlacementGroup: r["require_placement_group"].(bool),
+		}
+		for _, zone := range expandStrings(r["zones"]) {
+			rule.zones = append(rule.zones, scw.Zone(zone))
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// validateInstanceServerPlacement returns an error listing the placement rules violated by a server.
+// A rule applies when the server has its tag and its commercial type starts with its type prefix,
+// a rule without tag nor type prefix applies to every server.
+func validateInstanceServerPlacement(rules []instanceServerPlacementRule, tags []string, commercialType string, zone scw.Zone, hasPlacementGroup bool) error {
+	serverTags := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		serverTags[tag] = true
+	}
+
+	violations := []string(nil)
+	for i, rule := range rules {
+		if rule.tag != "" && !serverTags[rule.tag] {
+			continue
+		}
+		if rule.typePrefix != "" && !strings.HasPrefix(strings.ToUpper(commercialType), strings.ToUpper(rule.typePrefix)) {
+			continue
+		}
+
+		if rule.requirePlacementGroup && !hasPlacementGroup {
+			violations = append(violations, fmt.Sprintf("rule %d: the server must be in a placement group", i))
+		}
+		if len(rule.zones) > 0 && zone != "" {
+			allowed := false
+			for _, allowedZone := range rule.zones {
+				allowed = allowed || allowedZone == zone
+			}
+			if !allowed {
+				violations = append(violations, fmt.Sprintf("rule %d: zone %s is not one of %v", i, zone, rule.zones))
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("placement_validation: %s", strings.Join(violations, ", "))
+	}
+
+	return nil
+}
+
+// renderUserDataTemplate renders a template file with the Go text/template engine.
+// A variable used by the template but missing from vars is an error instead of an empty string.
+func renderUserDataTemplate(path string, vars map[string]interface{}) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read template: %s", err)
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %s", err)
+	}
+
+	if vars == nil {
+		vars = map[string]interface{}{}
+	}
+	rendered := &strings.Builder{}
+	err = tmpl.Execute(rendered, vars)
+	if err != nil {
+		return "", fmt.Errorf("cannot render template: %s", err)
+	}
+
+	return rendered.String(), nil
+}
+
+func validateCloudInitMIME(cloudInit string) error {
+	msg, err := mail.ReadMessage(strings.NewReader(cloudInit))
+	if err != nil {
+		return fmt.Errorf("invalid MIME cloud-init: %s", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return fmt.Errorf("invalid MIME cloud-init content type: %s", err)
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		if mediaType != "text/cloud-config" {
+			return nil
+		}
+		body, err := ioutil.ReadAll(msg.Body)
+		if err != nil {
+			return err
+		}
+		return validateCloudConfig(string(body))
+	}
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for index := 0; ; index++ {
+		part, err := reader.NextPart()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("invalid MIME cloud-init part %d: %s", index, err)
+		}
+
+		partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if partType != "text/cloud-config" {
+			continue
+		}
+
+		body, err := ioutil.ReadAll(part)
+		if err != nil {
+			return fmt.Errorf("invalid MIME cloud-init part %d: %s", index, err)
+		}
+		if err := validateCloudConfig(string(body)); err != nil {
+			return fmt.Errorf("MIME cloud-init part %d: %s", index, err)
+		}
+	}
+}
+
+// cloudInitContentType returns the MIME type of a cloud-init user data, detected from its first line.
+func cloudInitContentType(cloudInit string) (string, error) {
+	switch {
+	case strings.HasPrefix(cloudInit, "#cloud-config"):
+		return "text/cloud-config", nil
+	case strings.HasPrefix(cloudInit, "#cloud-boothook"):
+		return "text/cloud-boothook", nil
+	case strings.HasPrefix(cloudInit, "#include"):
+		return "text/x-include-url", nil
+	case strings.HasPrefix(cloudInit, "#upstart-job"):
+		return "text/upstart-job", nil
+	case strings.HasPrefix(cloudInit, "#part-handler"):
+		return "text/part-handler", nil
+	case strings.HasPrefix(cloudInit, "#!"):
+		return "text/x-shellscript", nil
+	case strings.HasPrefix(cloudInit, "Content-Type:") || strings.HasPrefix(cloudInit, "MIME-Version:"):
+		return "", fmt.Errorf("a MIME multipart document cannot be merged")
+	}
+	return "", fmt.Errorf("unknown cloud-init format, it must start with #cloud-config, #! or another cloud-init header")
+}
+
+// mergeCloudInit combines cloud-init user data in a MIME multipart document, each one being a part in the given order.
+// The cloud-config parts are merged together by cloud-init, a later part adding to the lists and maps of the previous ones.
+// The boundary is fixed so that the same inputs always give the same document.
+func mergeCloudInit(cloudInits ...string) (string, error) {
+	merged := &bytes.Buffer{}
+	merged.WriteString("Content-Type: multipart/mixed; boundary=\"" + cloudInitMergeBoundary + "\"\r\n")
+	merged.WriteString("MIME-Version: 1.0\r\n\r\n")
+
+	writer := multipart.NewWriter(merged)
+	if err := writer.SetBoundary(cloudInitMergeBoundary); err != nil {
+		return "", err
+	}
+	for index, cloudInit := range cloudInits {
+		if strings.Contains(cloudInit, cloudInitMergeBoundary) {
+			return "", fmt.Errorf("part %d contains the MIME boundary %s", index, cloudInitMergeBoundary)
+		}
+		contentType, err := cloudInitContentType(cloudInit)
+		if err != nil {
+			return "", fmt.Errorf("part %d: %s", index, err)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", contentType+`; charset="utf-8"`)
+		if contentType == "text/cloud-config" {
+			header.Set("Merge-Type", cloudInitMergeType)
+		}
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := part.Write([]byte(cloudInit)); err != nil {
+			return "", err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return merged.String(), nil
+}
+
+// validateInstanceImageSnapshots checks that the snapshots of an image, the root one first, can be used together:
+// they must be in the zone of the image, usable, and each one used once.
+// Snapshots do not carry an architecture, so the architecture of the image cannot be checked against them.
+func validateInstanceImageSnapshots(zone scw.Zone, snapshots []*instance.Snapshot) error {
+	seen := make(map[string]bool, len(snapshots))
+	for _, snapshot := range snapshots {
+		if snapshot.Zone != zone {
+			return fmt.Errorf("snapshot %s is in zone %s, the image is created in zone %s", snapshot.ID, snapshot.Zone, zone)
+		}
+		if snapshot.State != instance.SnapshotStateAvailable {
+			return fmt.Errorf("snapshot %s is in state %s, wants %s", snapshot.ID, snapshot.State, instance.SnapshotStateAvailable)
+		}
+		if seen[snapshot.ID] {
+			return fmt.Errorf("snapshot %s is used more than once in the image", snapshot.ID)
+		}
+		seen[snapshot.ID] = true
+	}
+
+	return nil
+}
+
+// flattenInstanceImageExtraVolumes returns the zoned IDs of the additional volumes of an image, ordered by index.
+func flattenInstanceImageExtraVolumes(zone scw.Zone, volumes map[string]*instance.Volume) []string {
+	indexes := make([]int, 0, len(volumes))
+	for index := range volumes {
+		i, err := strconv.Atoi(index)
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	ids := []string(nil)
+	for _, index := range indexes {
+		ids = append(ids, newZonedIDString(zone, volumes[strconv.Itoa(index)].ID))
+	}
+	return ids
+}
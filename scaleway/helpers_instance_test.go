@@ -0,0 +1,270 @@
+package scaleway
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCloudInit(t *testing.T) {
+	tests := []struct {
+		name      string
+		cloudInit string
+		isError   bool
+	}{
+		{
+			name:      "emptyCloudInit",
+			cloudInit: "",
+		},
+		{
+			name:      "shellScript",
+			cloudInit: "#!/bin/bash\necho: [not yaml",
+		},
+		{
+			name:      "validCloudConfig",
+			cloudInit: "#cloud-config\npackages:\n  - nginx\nruncmd:\n  - systemctl start nginx\n",
+		},
+		{
+			name:      "invalidCloudConfig",
+			cloudInit: "#cloud-config\npackages:\n  - nginx\n runcmd: [\n",
+			isError:   true,
+		},
+		{
+			name: "validMIME",
+			cloudInit: "Content-Type: multipart/mixed; boundary=\"BOUNDARY\"\nMIME-Version: 1.0\n\n" +
+				"--BOUNDARY\nContent-Type: text/cloud-config\n\npackages:\n  - nginx\n" +
+				"--BOUNDARY\nContent-Type: text/x-shellscript\n\n#!/bin/bash\necho hello\n" +
+				"--BOUNDARY--\n",
+		},
+		{
+			name: "invalidMIMEPart",
+			cloudInit: "Content-Type: multipart/mixed; boundary=\"BOUNDARY\"\nMIME-Version: 1.0\n\n" +
+				"--BOUNDARY\nContent-Type: text/cloud-config\n\npackages:\n  - nginx\n runcmd: [\n" +
+				"--BOUNDARY--\n",
+			isError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCloudInit(tt.cloudInit)
+			if tt.isError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "line")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRenderUserDataTemplate(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	valid := writeTemplate("valid.tpl", "#cloud-config\nhostname: {{ .hostname }}\n")
+	rendered, err := renderUserDataTemplate(valid, map[string]interface{}{"hostname": "web-01"})
+	assert.NoError(t, err)
+	assert.Equal(t, "#cloud-config\nhostname: web-01\n", rendered)
+
+	// variables used by the template must be provided
+	_, err = renderUserDataTemplate(valid, nil)
+	assert.ErrorContains(t, err, "hostname")
+
+	invalid := writeTemplate("invalid.tpl", "#cloud-config\nhostname: {{ .hostname }\n")
+	_, err = renderUserDataTemplate(invalid, map[string]interface{}{"hostname": "web-01"})
+	assert.ErrorContains(t, err, "invalid template")
+
+	_, err = renderUserDataTemplate(filepath.Join(dir, "missing.tpl"), nil)
+	assert.ErrorContains(t, err, "cannot read template")
+}
+
+func TestDHCPEntriesIP(t *testing.T) {
+	assert.Equal(t, "", dhcpEntriesIP(nil))
+	assert.Equal(t, "", dhcpEntriesIP([]*vpcgw.DHCPEntry{{Type: vpcgw.DHCPEntryTypeLease}}))
+	assert.Equal(t, "192.168.1.10", dhcpEntriesIP([]*vpcgw.DHCPEntry{
+		{Type: vpcgw.DHCPEntryTypeLease, IPAddress: net.ParseIP("192.168.1.10")},
+		{Type: vpcgw.DHCPEntryTypeLease, IPAddress: net.ParseIP("192.168.1.11")},
+	}))
+	// a reservation wins over a lease
+	assert.Equal(t, "192.168.1.2", dhcpEntriesIP([]*vpcgw.DHCPEntry{
+		{Type: vpcgw.DHCPEntryTypeLease, IPAddress: net.ParseIP("192.168.1.10")},
+		{Type: vpcgw.DHCPEntryTypeReservation, IPAddress: net.ParseIP("192.168.1.2")},
+	}))
+}
+
+func TestValidateInstanceServerPlacement(t *testing.T) {
+	rules := expandInstanceServerPlacementRules([]interface{}{
+		map[string]interface{}{
+			"tag":                     "ha",
+			"type_prefix":             "",
+			"require_placement_group": true,
+			"zones":                   []interface{}{},
+		},
+		map[string]interface{}{
+			"tag":                     "",
+			"type_prefix":             "gpu",
+			"require_placement_group": false,
+			"zones":                   []interface{}{"fr-par-2"},
+		},
+	})
+
+	tests := []struct {
+		name              string
+		tags              []string
+		commercialType    string
+		zone              scw.Zone
+		hasPlacementGroup bool
+		errorContains     []string
+	}{
+		{name: "no matching rule", tags: []string{"web"}, commercialType: "DEV1-S", zone: scw.ZoneFrPar1},
+		{name: "tag with placement group", tags: []string{"ha"}, commercialType: "DEV1-S", zone: scw.ZoneFrPar1, hasPlacementGroup: true},
+		{name: "tag without placement group", tags: []string{"web", "ha"}, commercialType: "DEV1-S", zone: scw.ZoneFrPar1, errorContains: []string{"rule 0", "placement group"}},
+		{name: "type in allowed zone", commercialType: "GPU-3070-S", zone: scw.ZoneFrPar2},
+		{name: "type in another zone", commercialType: "GPU-3070-S", zone: scw.ZoneFrPar1, errorContains: []string{"rule 1", "fr-par-1"}},
+		{name: "both rules violated", tags: []string{"ha"}, commercialType: "GPU-3070-S", zone: scw.ZoneNlAms1, errorContains: []string{"rule 0", "rule 1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateInstanceServerPlacement(rules, tt.tags, tt.commercialType, tt.zone, tt.hasPlacementGroup)
+			if len(tt.errorContains) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, contains := range tt.errorContains {
+				assert.ErrorContains(t, err, contains)
+			}
+		})
+	}
+}
+
+func TestValidateInstanceServerTypeAvailability(t *testing.T) {
+	availabilities := map[string]*instance.GetServerTypesAvailabilityResponseAvailability{
+		"DEV1-S":     {Availability: instance.ServerTypesAvailabilityAvailable},
+		"GPU-3070-S": {Availability: instance.ServerTypesAvailabilityScarce},
+	}
+
+	assert.NoError(t, validateInstanceServerTypeAvailability(availabilities, "DEV1-S", scw.ZoneFrPar1))
+	assert.NoError(t, validateInstanceServerTypeAvailability(availabilities, "gpu-3070-s", scw.ZoneFrPar1))
+	assert.EqualError(t, validateInstanceServerTypeAvailability(availabilities, "RENDER-S", scw.ZoneFrPar1), "instance type RENDER-S is not available in zone fr-par-1")
+}
+
+func TestFlattenInstanceServerTypes(t *testing.T) {
+	gpu := uint64(1)
+	serverTypes := map[string]*instance.ServerType{
+		"GPU-3070-S": {
+			Ncpus:             8,
+			Gpu:               &gpu,
+			RAM:               16 * 1024 * 1024 * 1024,
+			Arch:              instance.ArchX86_64,
+			HourlyPrice:       0.98,
+			VolumesConstraint: &instance.ServerTypeVolumeConstraintSizes{MinSize: 0, MaxSize: 400 * scw.GB},
+		},
+		"DEV1-S": {
+			Ncpus:             2,
+			RAM:               2 * 1024 * 1024 * 1024,
+			Arch:              instance.ArchX86_64,
+			HourlyPrice:       0.01,
+			VolumesConstraint: &instance.ServerTypeVolumeConstraintSizes{MinSize: 0, MaxSize: 20 * scw.GB},
+		},
+		"PRO2-XXS": {
+			Ncpus:             2,
+			RAM:               8 * 1024 * 1024 * 1024,
+			Arch:              instance.ArchX86_64,
+			VolumesConstraint: &instance.ServerTypeVolumeConstraintSizes{},
+		},
+	}
+	availabilities := map[string]*instance.GetServerTypesAvailabilityResponseAvailability{
+		"DEV1-S":     {Availability: instance.ServerTypesAvailabilityAvailable},
+		"GPU-3070-S": {Availability: instance.ServerTypesAvailabilityShortage},
+	}
+
+	flattened := flattenInstanceServerTypes(serverTypes, availabilities, "")
+	require.Len(t, flattened, 3)
+	assert.Equal(t, "DEV1-S", flattened[0]["name"])
+	assert.Equal(t, []string{"b_ssd", "l_ssd"}, flattened[0]["volume_types"])
+	assert.Equal(t, 20, flattened[0]["local_volume_max_size_in_gb"])
+	assert.Equal(t, "available", flattened[0]["availability"])
+	assert.Equal(t, "GPU-3070-S", flattened[1]["name"])
+	assert.Equal(t, 1, flattened[1]["gpus"])
+	assert.Equal(t, "shortage", flattened[1]["availability"])
+	assert.Equal(t, "PRO2-XXS", flattened[2]["name"])
+	assert.Equal(t, 0, flattened[2]["gpus"])
+	assert.Equal(t, []string{"b_ssd"}, flattened[2]["volume_types"])
+	assert.Equal(t, "", flattened[2]["availability"])
+
+	flattened = flattenInstanceServerTypes(serverTypes, availabilities, "dev1-s")
+	require.Len(t, flattened, 1)
+	assert.Equal(t, "DEV1-S", flattened[0]["name"])
+	assert.Equal(t, 2, flattened[0]["cpus"])
+}
+
+func TestValidateInstanceImageSnapshots(t *testing.T) {
+	root := &instance.Snapshot{ID: "11111111-1111-1111-1111-111111111111", Zone: scw.ZoneFrPar1, State: instance.SnapshotStateAvailable}
+	extra := &instance.Snapshot{ID: "22222222-2222-2222-2222-222222222222", Zone: scw.ZoneFrPar1, State: instance.SnapshotStateAvailable}
+
+	assert.NoError(t, validateInstanceImageSnapshots(scw.ZoneFrPar1, []*instance.Snapshot{root}))
+	assert.NoError(t, validateInstanceImageSnapshots(scw.ZoneFrPar1, []*instance.Snapshot{root, extra}))
+	assert.EqualError(t, validateInstanceImageSnapshots(scw.ZoneFrPar2, []*instance.Snapshot{root}),
+		"snapshot 11111111-1111-1111-1111-111111111111 is in zone fr-par-1, the image is created in zone fr-par-2")
+	assert.EqualError(t, validateInstanceImageSnapshots(scw.ZoneFrPar1, []*instance.Snapshot{root, root}),
+		"snapshot 11111111-1111-1111-1111-111111111111 is used more than once in the image")
+	assert.EqualError(t, validateInstanceImageSnapshots(scw.ZoneFrPar1, []*instance.Snapshot{root, {ID: extra.ID, Zone: scw.ZoneFrPar1, State: instance.SnapshotStateError}}),
+		"snapshot 22222222-2222-2222-2222-222222222222 is in state error, wants available")
+}
+
+func TestFlattenInstanceImageExtraVolumes(t *testing.T) {
+	volumes := map[string]*instance.Volume{}
+	for i := 1; i <= 11; i++ {
+		volumes[strconv.Itoa(i)] = &instance.Volume{ID: fmt.Sprintf("volume-%d", i)}
+	}
+
+	ids := flattenInstanceImageExtraVolumes(scw.ZoneFrPar1, volumes)
+	require.Len(t, ids, 11)
+	assert.Equal(t, "fr-par-1/volume-1", ids[0])
+	assert.Equal(t, "fr-par-1/volume-2", ids[1])
+	assert.Equal(t, "fr-par-1/volume-11", ids[10])
+	assert.Nil(t, flattenInstanceImageExtraVolumes(scw.ZoneFrPar1, nil))
+}
+
+func TestMergeCloudInit(t *testing.T) {
+	base := "#cloud-config\npackages:\n  - curl\n"
+	app := "#cloud-config\npackages:\n  - nginx\n"
+	script := "#!/bin/sh\necho ready\n"
+
+	merged, err := mergeCloudInit(base, app, script)
+	require.NoError(t, err)
+	assert.NoError(t, validateCloudInit(merged))
+	assert.True(t, strings.HasPrefix(merged, "Content-Type: multipart/mixed; boundary=\"==SCALEWAY-CLOUD-INIT==\"\r\n"))
+	assert.Equal(t, 2, strings.Count(merged, "Merge-Type: list(append)+dict(recurse_array)+str()"))
+	assert.Contains(t, merged, "Content-Type: text/x-shellscript; charset=\"utf-8\"")
+	assert.Less(t, strings.Index(merged, "curl"), strings.Index(merged, "nginx"))
+
+	again, err := mergeCloudInit(base, app, script)
+	require.NoError(t, err)
+	assert.Equal(t, merged, again, "merging the same inputs must be stable")
+
+	merged, err = mergeCloudInit(base, "#cloud-config\npackages: [nginx\n")
+	require.NoError(t, err)
+	assert.Error(t, validateCloudInit(merged), "an invalid cloud-config part must be reported")
+
+	_, err = mergeCloudInit(base, "packages: [nginx]")
+	assert.EqualError(t, err, "part 1: unknown cloud-init format, it must start with #cloud-config, #! or another cloud-init header")
+
+	_, err = mergeCloudInit("Content-Type: multipart/mixed; boundary=\"x\"\n\n--x--\n", app)
+	assert.EqualError(t, err, "part 0: a MIME multipart document cannot be merged")
+}
@@ -7,6 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -75,6 +78,35 @@ func k8sGetLatestVersionFromMinor(ctx context.Context, k8sAPI *k8s.API, region s
 	return "", fmt.Errorf("no available upstream version found for %s", version)
 }
 
+// validateK8SVersionFeatures checks that the feature gates and admission plugins are available in the version
+func validateK8SVersionFeatures(version *k8s.Version, featureGates []string, admissionPlugins []string) error {
+	availableFeatureGates := make(map[string]bool, len(version.AvailableFeatureGates))
+	for _, featureGate := range version.AvailableFeatureGates {
+		availableFeatureGates[featureGate] = true
+	}
+	availableAdmissionPlugins := make(map[string]bool, len(version.AvailableAdmissionPlugins))
+	for _, admissionPlugin := range version.AvailableAdmissionPlugins {
+		availableAdmissionPlugins[admissionPlugin] = true
+	}
+
+	var errs []string
+	for _, featureGate := range featureGates {
+		if !availableFeatureGates[featureGate] {
+			errs = append(errs, fmt.Sprintf("feature gate %s is not available in version %s, available feature gates: %s", featureGate, version.Name, strings.Join(version.AvailableFeatureGates, ", ")))
+		}
+	}
+	for _, admissionPlugin := range admissionPlugins {
+		if !availableAdmissionPlugins[admissionPlugin] {
+			errs = append(errs, fmt.Sprintf("admission plugin %s is not available in version %s, available admission plugins: %s", admissionPlugin, version.Name, strings.Join(version.AvailableAdmissionPlugins, ", ")))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
 func waitK8SCluster(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) (*k8s.Cluster, error) {
 	retryInterval := defaultK8SRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -105,12 +137,15 @@ func waitK8SClusterPool(ctx context.Context, k8sAPI *k8s.API, region scw.Region,
 	}, scw.WithContext(ctx))
 }
 
+// waitK8SClusterDeleted waits for the cluster to be gone.
+// The cluster reaches the deleted status before its additional resources are cleaned up, so the wait goes on until the cluster is not found.
 func waitK8SClusterDeleted(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) error {
 	retryInterval := defaultK8SRetryInterval
 	if DefaultWaitRetryInterval != nil {
 		retryInterval = *DefaultWaitRetryInterval
 	}
 
+	deadline := time.Now().Add(timeout)
 	cluster, err := k8sAPI.WaitForCluster(&k8s.WaitForClusterRequest{
 		ClusterID:     clusterID,
 		Region:        region,
@@ -125,7 +160,96 @@ func waitK8SClusterDeleted(ctx context.Context, k8sAPI *k8s.API, region scw.Regi
 		return err
 	}
 
-	return fmt.Errorf("cluster %s has state %s, wants %s", clusterID, cluster.Status, k8s.ClusterStatusDeleted)
+	if cluster.Status != k8s.ClusterStatusDeleted {
+		return fmt.Errorf("cluster %s has state %s, wants %s", clusterID, cluster.Status, k8s.ClusterStatusDeleted)
+	}
+
+	return resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
+		cluster, err := k8sAPI.GetCluster(&k8s.GetClusterRequest{
+			Region:    region,
+			ClusterID: clusterID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if is404Error(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("cluster %s has state %s, waiting for its additional resources to be deleted", clusterID, cluster.Status))
+	})
+}
+
+func waitK8SPoolDeleted(ctx context.Context, k8sAPI *k8s.API, region scw.Region, poolID string, timeout time.Duration) error {
+	retryInterval := defaultK8SRetryInterval
+	if DefaultWaitRetryInterval != nil {
+		retryInterval = *DefaultWaitRetryInterval
+	}
+
+	pool, err := k8sAPI.WaitForPool(&k8s.WaitForPoolRequest{
+		PoolID:        poolID,
+		Region:        region,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: &retryInterval,
+	}, scw.WithContext(ctx))
+
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return err
+	}
+
+	return fmt.Errorf("pool %s has state %s, wants %s", poolID, pool.Status, k8s.PoolStatusDeleted)
+}
+
+// deleteK8SClusterPools deletes every pool of a cluster and waits for their deletion.
+// Each pool that could not be deleted is reported in its own diagnostic so that a partial failure is explicit.
+func deleteK8SClusterPools(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) diag.Diagnostics {
+	res, err := k8sAPI.ListPools(&k8s.ListPoolsRequest{
+		Region:    region,
+		ClusterID: clusterID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	deletedPools := make([]*k8s.Pool, 0, len(res.Pools))
+	for _, pool := range res.Pools {
+		if pool.Status != k8s.PoolStatusDeleting {
+			_, err := k8sAPI.DeletePool(&k8s.DeletePoolRequest{
+				Region: region,
+				PoolID: pool.ID,
+			}, scw.WithContext(ctx))
+			if err != nil && !is404Error(err) {
+				diags = append(diags, k8sPoolDeletionDiagnostic(pool, err))
+				continue
+			}
+		}
+		deletedPools = append(deletedPools, pool)
+	}
+
+	// The pools are deleted concurrently by the API, so they share the same deadline
+	deadline := time.Now().Add(timeout)
+	for _, pool := range deletedPools {
+		err := waitK8SPoolDeleted(ctx, k8sAPI, region, pool.ID, time.Until(deadline))
+		if err != nil {
+			diags = append(diags, k8sPoolDeletionDiagnostic(pool, err))
+		}
+	}
+
+	return diags
+}
+
+func k8sPoolDeletionDiagnostic(pool *k8s.Pool, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("failed to delete pool %s (%s)", pool.Name, pool.ID),
+		Detail:   fmt.Sprintf("%s, the cluster %s has not been deleted", err, pool.ClusterID),
+	}
 }
 
 func waitK8SPoolReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, poolID string, timeout time.Duration) (*k8s.Pool, error) {
@@ -151,13 +275,135 @@ func waitK8SPoolReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, p
 	return pool, nil
 }
 
+func waitK8SNodeReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, nodeID string, timeout time.Duration) (*k8s.Node, error) {
+	retryInterval := defaultK8SRetryInterval
+	if DefaultWaitRetryInterval != nil {
+		retryInterval = *DefaultWaitRetryInterval
+	}
+
+	node, err := k8sAPI.WaitForNode(&k8s.WaitForNodeRequest{
+		NodeID:        nodeID,
+		Region:        region,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		RetryInterval: &retryInterval,
+	}, scw.WithContext(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if node.Status != k8s.NodeStatusReady {
+		return nil, fmt.Errorf("node %s has state %s, wants %s", nodeID, node.Status, k8s.NodeStatusReady)
+	}
+	return node, nil
+}
+
+// waitK8SNodeReplacementStarted waits for a node being replaced to leave the ready status, or to be gone.
+// WaitForNode returns as soon as the node is ready, so it would return right away for a node whose replacement has not started yet.
+func waitK8SNodeReplacementStarted(ctx context.Context, k8sAPI *k8s.API, region scw.Region, nodeID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		node, err := k8sAPI.GetNode(&k8s.GetNodeRequest{
+			Region: region,
+			NodeID: nodeID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if is404Error(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		if node.Status == k8s.NodeStatusReady {
+			return resource.RetryableError(fmt.Errorf("node %s is still %s", nodeID, node.Status))
+		}
+		return nil
+	})
+}
+
+// recycleK8SPoolNodes replaces the nodes of a pool one at a time, each new node being ready before the next one is replaced.
+// A pool without autoscaling at its minimum size is grown by one node during the recycling so that its capacity is kept,
+// unless the pool is already at its maximum size.
+func recycleK8SPoolNodes(ctx context.Context, k8sAPI *k8s.API, pool *k8s.Pool, timeout time.Duration) error {
+	nodes, err := k8sAPI.ListNodes(&k8s.ListNodesRequest{
+		Region:    pool.Region,
+		ClusterID: pool.ClusterID,
+		PoolID:    &pool.ID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	surge := !pool.Autoscaling && pool.Size <= pool.MinSize && pool.Size < pool.MaxSize
+	if surge {
+		tflog.Info(ctx, fmt.Sprintf("adding a node to pool %s while its nodes are recycled", pool.Name))
+		err = k8sPoolResize(ctx, k8sAPI, pool, pool.Size+1, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, node := range nodes.Nodes {
+		tflog.Info(ctx, fmt.Sprintf("recycling node %s of pool %s (%d/%d)", node.Name, pool.Name, i+1, len(nodes.Nodes)))
+
+		_, err = k8sAPI.ReplaceNode(&k8s.ReplaceNodeRequest{
+			Region: pool.Region,
+			NodeID: node.ID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+
+		err = waitK8SNodeReplacementStarted(ctx, k8sAPI, pool.Region, node.ID, timeout)
+		if err != nil {
+			return err
+		}
+
+		_, err = waitK8SNodeReady(ctx, k8sAPI, pool.Region, node.ID, timeout)
+		if err != nil {
+			if !is404Error(err) {
+				return err
+			}
+			// the node was recreated under a new ID, wait for the pool to get back its capacity
+			_, err = waitK8SPoolReady(ctx, k8sAPI, pool.Region, pool.ID, timeout)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if surge {
+		tflog.Info(ctx, fmt.Sprintf("removing the node added to pool %s", pool.Name))
+		err = k8sPoolResize(ctx, k8sAPI, pool, pool.Size, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func k8sPoolResize(ctx context.Context, k8sAPI *k8s.API, pool *k8s.Pool, size uint32, timeout time.Duration) error {
+	_, err := k8sAPI.UpdatePool(&k8s.UpdatePoolRequest{
+		Region: pool.Region,
+		PoolID: pool.ID,
+		Size:   scw.Uint32Ptr(size),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_, err = waitK8SPoolReady(ctx, k8sAPI, pool.Region, pool.ID, timeout)
+	return err
+}
+
 // convert a list of nodes to a list of map
-func convertNodes(res *k8s.ListNodesResponse) []map[string]interface{} {
+// nodes do not carry their zone, they are spawned in the zone of their pool
+func convertNodes(res *k8s.ListNodesResponse, zone scw.Zone) []map[string]interface{} {
 	var result []map[string]interface{}
 	for _, node := range res.Nodes {
 		n := make(map[string]interface{})
 		n["name"] = node.Name
 		n["status"] = node.Status.String()
+		n["zone"] = zone.String()
 		if node.PublicIPV4 != nil && node.PublicIPV4.String() != "<nil>" {
 			n["public_ip"] = node.PublicIPV4.String()
 		}
@@ -182,7 +428,18 @@ func getNodes(ctx context.Context, k8sAPI *k8s.API, pool *k8s.Pool) ([]map[strin
 		return nil, err
 	}
 
-	return convertNodes(nodes), nil
+	return convertNodes(nodes, pool.Zone), nil
+}
+
+// validateK8SPoolZone checks that the zone of a pool belongs to the region of its cluster
+func validateK8SPoolZone(zone scw.Zone, region scw.Region) error {
+	for _, z := range region.GetZones() {
+		if z == zone {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("zone %s is not in the region %s of the cluster", zone, region)
 }
 
 func clusterAutoscalerConfigFlatten(cluster *k8s.Cluster) []map[string]interface{} {
@@ -209,6 +466,10 @@ func clusterAutoscalerConfigFlatten(cluster *k8s.Cluster) []map[string]interface
 }
 
 func clusterOpenIDConnectConfigFlatten(cluster *k8s.Cluster) []map[string]interface{} {
+	if cluster.OpenIDConnectConfig == nil {
+		return nil
+	}
+
 	openIDConnectConfig := map[string]interface{}{}
 	openIDConnectConfig["issuer_url"] = cluster.OpenIDConnectConfig.IssuerURL
 	openIDConnectConfig["client_id"] = cluster.OpenIDConnectConfig.ClientID
@@ -259,3 +520,15 @@ func flattenKubeletArgs(args map[string]string) map[string]interface{} {
 
 	return kubeletArgs
 }
+
+// k8sClusterIDsWithName returns the IDs of the clusters whose name is exactly name,
+// the name filter of the API matching on a prefix.
+func k8sClusterIDsWithName(clusters []*k8s.Cluster, name string) []string {
+	ids := []string(nil)
+	for _, cluster := range clusters {
+		if cluster.Name == name {
+			ids = append(ids, cluster.ID)
+		}
+	}
+	return ids
+}
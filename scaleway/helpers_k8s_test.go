@@ -0,0 +1,118 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateK8SPoolZone(t *testing.T) {
+	tests := []struct {
+		name    string
+		zone    scw.Zone
+		region  scw.Region
+		wantErr bool
+	}{
+		{
+			name:   "zone in region",
+			zone:   scw.ZoneFrPar2,
+			region: scw.RegionFrPar,
+		},
+		{
+			name:    "zone in another region",
+			zone:    scw.ZoneNlAms1,
+			region:  scw.RegionFrPar,
+			wantErr: true,
+		},
+		{
+			name:    "unknown region",
+			zone:    scw.ZoneFrPar1,
+			region:  scw.Region("xx-xxx"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateK8SPoolZone(tt.zone, tt.region)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConvertNodes(t *testing.T) {
+	nodes := convertNodes(&k8s.ListNodesResponse{
+		Nodes: []*k8s.Node{
+			{Name: "node-1", Status: k8s.NodeStatusReady},
+			{Name: "node-2", Status: k8s.NodeStatusCreating},
+		},
+	}, scw.ZoneFrPar2)
+
+	assert.Len(t, nodes, 2)
+	assert.Equal(t, "node-1", nodes[0]["name"])
+	assert.Equal(t, "ready", nodes[0]["status"])
+	assert.Equal(t, "fr-par-2", nodes[0]["zone"])
+	assert.Equal(t, "fr-par-2", nodes[1]["zone"])
+}
+
+func TestValidateK8SVersionFeatures(t *testing.T) {
+	version := &k8s.Version{
+		Name:                      "1.23.4",
+		AvailableFeatureGates:     []string{"EphemeralContainers", "HPAScaleToZero"},
+		AvailableAdmissionPlugins: []string{"PodNodeSelector", "AlwaysPullImages"},
+	}
+
+	tests := []struct {
+		name             string
+		featureGates     []string
+		admissionPlugins []string
+		wantErr          bool
+	}{
+		{"nothing", nil, nil, false},
+		{"available", []string{"HPAScaleToZero"}, []string{"AlwaysPullImages", "PodNodeSelector"}, false},
+		{"unknown feature gate", []string{"HPAScaleToZero", "Unknown"}, nil, true},
+		{"unknown admission plugin", nil, []string{"Unknown"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateK8SVersionFeatures(version, tt.featureGates, tt.admissionPlugins)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestK8SPoolDeletionDiagnostic(t *testing.T) {
+	d := k8sPoolDeletionDiagnostic(&k8s.Pool{
+		ID:        "11111111-1111-1111-1111-111111111111",
+		ClusterID: "22222222-2222-2222-2222-222222222222",
+		Name:      "default",
+	}, fmt.Errorf("timeout"))
+
+	assert.Equal(t, diag.Error, d.Severity)
+	assert.Equal(t, "failed to delete pool default (11111111-1111-1111-1111-111111111111)", d.Summary)
+	assert.Equal(t, "timeout, the cluster 22222222-2222-2222-2222-222222222222 has not been deleted", d.Detail)
+}
+
+func TestK8SClusterIDsWithName(t *testing.T) {
+	clusters := []*k8s.Cluster{
+		{ID: "11111111-1111-1111-1111-111111111111", Name: "prod"},
+		{ID: "22222222-2222-2222-2222-222222222222", Name: "prod-2"},
+		{ID: "33333333-3333-3333-3333-333333333333", Name: "prod"},
+	}
+
+	assert.Equal(t, []string{"11111111-1111-1111-1111-111111111111", "33333333-3333-3333-3333-333333333333"}, k8sClusterIDsWithName(clusters, "prod"))
+	assert.Equal(t, []string{"22222222-2222-2222-2222-222222222222"}, k8sClusterIDsWithName(clusters, "prod-2"))
+	assert.Empty(t, k8sClusterIDsWithName(clusters, "staging"))
+}
@@ -1,15 +1,19 @@
 package scaleway
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"net"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	validator "github.com/scaleway/scaleway-sdk-go/validation"
@@ -435,6 +439,38 @@ func expandLbPrivateNetworkDHCPConfig(raw interface{}) *lbSDK.PrivateNetworkDHCP
 	return &lbSDK.PrivateNetworkDHCPConfig{}
 }
 
+// validateLbRouteMatch checks that the route match is compatible with the protocol of the backend of the route.
+// SNI matching is only possible on TCP traffic as HTTP traffic has its TLS connection terminated by the load balancer.
+func validateLbRouteMatch(backend *lbSDK.Backend, sni string) error {
+	if sni == "" {
+		return nil
+	}
+
+	if backend.ForwardProtocol != lbSDK.ProtocolTCP {
+		return fmt.Errorf("match_sni can only be used with a tcp backend, backend %s uses %s", backend.ID, backend.ForwardProtocol)
+	}
+
+	return nil
+}
+
+// checkLbRouteMatch fetches the backend of a route to check the route match against its protocol.
+// The backend is only fetched when the route matches on SNI.
+func checkLbRouteMatch(ctx context.Context, lbAPI *lbSDK.ZonedAPI, zone scw.Zone, backendID string, sni string) error {
+	if sni == "" {
+		return nil
+	}
+
+	backend, err := lbAPI.GetBackend(&lbSDK.ZonedAPIGetBackendRequest{
+		Zone:      zone,
+		BackendID: backendID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	return validateLbRouteMatch(backend, sni)
+}
+
 func waitForLB(ctx context.Context, lbAPI *lbSDK.ZonedAPI, zone scw.Zone, LbID string, timeout time.Duration) (*lbSDK.LB, error) {
 	retryInterval := defaultWaitLBRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -532,3 +568,134 @@ func attachLBPrivateNetwork(ctx context.Context, lbAPI *lbSDK.ZonedAPI, zone scw
 
 	return privateNetworks, nil
 }
+
+// flattenLbBackendServersStats flattens the stats of the servers of a backend, sorted by server IP
+func flattenLbBackendServersStats(stats []*lbSDK.BackendServerStats) []map[string]interface{} {
+	sorted := make([]*lbSDK.BackendServerStats, len(stats))
+	copy(sorted, stats)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bytes.Compare(net.ParseIP(sorted[i].IP).To16(), net.ParseIP(sorted[j].IP).To16()) < 0
+	})
+
+	servers := make([]map[string]interface{}, 0, len(sorted))
+	for _, stat := range sorted {
+		servers = append(servers, map[string]interface{}{
+			"ip":                       stat.IP,
+			"server_state":             stat.ServerState.String(),
+			"server_state_changed_at":  flattenTime(stat.ServerStateChangedAt),
+			"last_health_check_status": stat.LastHealthCheckStatus.String(),
+		})
+	}
+
+	return servers
+}
+
+// flattenLbBackendsHealthCounts counts the servers of each backend by the result of their last health check.
+// Backends are sorted by ID so that the list does not move between reads.
+func flattenLbBackendsHealthCounts(stats []*lbSDK.BackendServerStats) []map[string]interface{} {
+	counts := map[string]map[string]interface{}{}
+	ids := []string(nil)
+	for _, stat := range stats {
+		count, ok := counts[stat.BackendID]
+		if !ok {
+			count = map[string]interface{}{
+				"backend_id":      stat.BackendID,
+				"servers_count":   0,
+				"healthy_count":   0,
+				"unhealthy_count": 0,
+				"unknown_count":   0,
+			}
+			counts[stat.BackendID] = count
+			ids = append(ids, stat.BackendID)
+		}
+
+		count["servers_count"] = count["servers_count"].(int) + 1
+		switch stat.LastHealthCheckStatus {
+		case lbSDK.BackendServerStatsHealthCheckStatusPassed, lbSDK.BackendServerStatsHealthCheckStatusCondpass:
+			count["healthy_count"] = count["healthy_count"].(int) + 1
+		case lbSDK.BackendServerStatsHealthCheckStatusFailed:
+			count["unhealthy_count"] = count["unhealthy_count"].(int) + 1
+		default:
+			count["unknown_count"] = count["unknown_count"].(int) + 1
+		}
+	}
+
+	sort.Strings(ids)
+	backends := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		backends = append(backends, counts[id])
+	}
+
+	return backends
+}
+
+// lbBackendResolveServerIPs returns the public IPs of the instance servers of a zone having all the given tags, sorted.
+// Servers without a public IP are skipped: a load balancer cannot reach their private NICs.
+func lbBackendResolveServerIPs(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, tags []string) ([]string, error) {
+	res, err := instanceAPI.ListServers(&instance.ListServersRequest{
+		Zone: zone,
+		Tags: tags,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ips := []string{}
+	for _, server := range res.Servers {
+		if server.PublicIP == nil || server.PublicIP.Address == nil {
+			tflog.Debug(ctx, fmt.Sprintf("server %s has no public IP, it is not added to the backend", server.ID))
+			continue
+		}
+		ips = append(ips, server.PublicIP.Address.String())
+	}
+	sort.Strings(ips)
+
+	return ips, nil
+}
+
+// expandLbBackendServerIPs returns the configured server IPs followed by the resolved ones, without duplicates
+func expandLbBackendServerIPs(d *schema.ResourceData) []string {
+	ips := []string{}
+	seen := map[string]bool{}
+	for _, ip := range append(expandStrings(d.Get("server_ips")), expandStrings(d.Get("resolved_server_ips"))...) {
+		if !seen[ip] {
+			seen[ip] = true
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips
+}
+
+// splitLbBackendPool splits the servers of a backend between the configured ones and the others, resolved from tags
+func splitLbBackendPool(pool []string, configured []string) ([]string, []string) {
+	isConfigured := make(map[string]bool, len(configured))
+	for _, ip := range configured {
+		isConfigured[ip] = true
+	}
+
+	serverIPs := []string{}
+	resolvedServerIPs := []string{}
+	for _, ip := range pool {
+		if isConfigured[ip] {
+			serverIPs = append(serverIPs, ip)
+		} else {
+			resolvedServerIPs = append(resolvedServerIPs, ip)
+		}
+	}
+	sort.Strings(resolvedServerIPs)
+
+	return serverIPs, resolvedServerIPs
+}
+
+// lbIDsWithName returns the IDs of the load-balancers whose name is exactly name,
+// the name filter of the API matching on a prefix.
+func lbIDsWithName(lbs []*lbSDK.LB, name string) []string {
+	ids := []string(nil)
+	for _, lb := range lbs {
+		if lb.Name == name {
+			ids = append(ids, lb.ID)
+		}
+	}
+	return ids
+}
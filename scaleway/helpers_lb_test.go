@@ -51,3 +51,110 @@ func TestIsEqualPrivateNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLbRouteMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend *lbSDK.Backend
+		sni     string
+		isError bool
+	}{
+		{
+			name:    "sniOnTCP",
+			backend: &lbSDK.Backend{ForwardProtocol: lbSDK.ProtocolTCP},
+			sni:     "scaleway.com",
+			isError: false,
+		},
+		{
+			name:    "sniOnHTTP",
+			backend: &lbSDK.Backend{ForwardProtocol: lbSDK.ProtocolHTTP},
+			sni:     "scaleway.com",
+			isError: true,
+		},
+		{
+			name:    "noMatchOnHTTP",
+			backend: &lbSDK.Backend{ForwardProtocol: lbSDK.ProtocolHTTP},
+			sni:     "",
+			isError: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLbRouteMatch(tt.backend, tt.sni)
+			if tt.isError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFlattenLbBackendServersStats(t *testing.T) {
+	stats := []*lbSDK.BackendServerStats{
+		{IP: "10.0.0.10", ServerState: lbSDK.BackendServerStatsServerStateRunning, LastHealthCheckStatus: lbSDK.BackendServerStatsHealthCheckStatusPassed},
+		{IP: "10.0.0.9", ServerState: lbSDK.BackendServerStatsServerStateStopped, LastHealthCheckStatus: lbSDK.BackendServerStatsHealthCheckStatusFailed},
+		{IP: "9.0.0.1", ServerState: lbSDK.BackendServerStatsServerStateRunning, LastHealthCheckStatus: lbSDK.BackendServerStatsHealthCheckStatusPassed},
+	}
+
+	servers := flattenLbBackendServersStats(stats)
+	assert.Len(t, servers, 3)
+	// sorted by IP, not by string
+	assert.Equal(t, "9.0.0.1", servers[0]["ip"])
+	assert.Equal(t, "10.0.0.9", servers[1]["ip"])
+	assert.Equal(t, "failed", servers[1]["last_health_check_status"])
+	assert.Equal(t, "stopped", servers[1]["server_state"])
+	assert.Equal(t, "10.0.0.10", servers[2]["ip"])
+	// input is left untouched
+	assert.Equal(t, "10.0.0.10", stats[0].IP)
+}
+
+func TestFlattenLbBackendsHealthCounts(t *testing.T) {
+	stats := []*lbSDK.BackendServerStats{
+		{BackendID: "b2", IP: "10.0.0.1", LastHealthCheckStatus: lbSDK.BackendServerStatsHealthCheckStatusPassed},
+		{BackendID: "b1", IP: "10.0.0.2", LastHealthCheckStatus: lbSDK.BackendServerStatsHealthCheckStatusFailed},
+		{BackendID: "b2", IP: "10.0.0.3", LastHealthCheckStatus: lbSDK.BackendServerStatsHealthCheckStatusCondpass},
+		{BackendID: "b2", IP: "10.0.0.4", LastHealthCheckStatus: lbSDK.BackendServerStatsHealthCheckStatusNeutral},
+		{BackendID: "b1", IP: "10.0.0.5", LastHealthCheckStatus: lbSDK.BackendServerStatsHealthCheckStatusUnknown},
+	}
+
+	backends := flattenLbBackendsHealthCounts(stats)
+	assert.Len(t, backends, 2)
+	assert.Equal(t, map[string]interface{}{
+		"backend_id":      "b1",
+		"servers_count":   2,
+		"healthy_count":   0,
+		"unhealthy_count": 1,
+		"unknown_count":   1,
+	}, backends[0])
+	assert.Equal(t, map[string]interface{}{
+		"backend_id":      "b2",
+		"servers_count":   3,
+		"healthy_count":   2,
+		"unhealthy_count": 0,
+		"unknown_count":   1,
+	}, backends[1])
+
+	assert.Empty(t, flattenLbBackendsHealthCounts(nil))
+}
+
+func TestSplitLbBackendPool(t *testing.T) {
+	serverIPs, resolvedServerIPs := splitLbBackendPool([]string{"10.0.0.3", "10.0.0.1", "10.0.0.2"}, []string{"10.0.0.1", "10.0.0.9"})
+	assert.Equal(t, []string{"10.0.0.1"}, serverIPs)
+	assert.Equal(t, []string{"10.0.0.2", "10.0.0.3"}, resolvedServerIPs)
+
+	serverIPs, resolvedServerIPs = splitLbBackendPool(nil, nil)
+	assert.Empty(t, serverIPs)
+	assert.Empty(t, resolvedServerIPs)
+}
+
+func TestLbIDsWithName(t *testing.T) {
+	lbs := []*lbSDK.LB{
+		{ID: "11111111-1111-1111-1111-111111111111", Name: "front"},
+		{ID: "22222222-2222-2222-2222-222222222222", Name: "front-2"},
+		{ID: "33333333-3333-3333-3333-333333333333", Name: "front"},
+	}
+	assert.Equal(t, []string{"11111111-1111-1111-1111-111111111111", "33333333-3333-3333-3333-333333333333"}, lbIDsWithName(lbs, "front"))
+	assert.Equal(t, []string{"22222222-2222-2222-2222-222222222222"}, lbIDsWithName(lbs, "front-2"))
+	assert.Empty(t, lbIDsWithName(lbs, "back"))
+}
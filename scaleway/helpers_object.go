@@ -8,7 +8,9 @@ import (
 	"hash/crc32"
 	"net/http"
 	"os"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,15 +19,21 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 const (
 	defaultObjectBucketTimeout = 10 * time.Minute
 	retryOnAWSAPI              = 2 * time.Minute
+
+	// objectBucketARNPrefix is the prefix of the bucket ARNs expected by the S3 API
+	objectBucketARNPrefix = "arn:aws:s3:::"
 )
 
 func newS3Client(httpClient *http.Client, region, accessKey, secretKey string) (*s3.S3, error) {
@@ -131,6 +139,75 @@ func isS3Err(err error, code string, message string) bool {
 	return false
 }
 
+// objectBucketOptionalConfiguration is a bucket configuration that the object storage does not implement in every region.
+// An error matching notFoundCode means that the configuration is not set on the bucket.
+type objectBucketOptionalConfiguration struct {
+	key          string
+	notFoundCode string
+	read         func() (interface{}, error)
+}
+
+// readObjectBucketOptionalConfigurations reads the optional configurations of a bucket concurrently, then sets each key
+// to the value returned by its read function: where a configuration is not implemented, the value in state is kept,
+// and where it is not set on the bucket, the key is reset.
+// Only the configurations set in state are read, so that a bucket that does not use them costs no extra request.
+func readObjectBucketOptionalConfigurations(ctx context.Context, d *schema.ResourceData, allConfigurations []objectBucketOptionalConfiguration) error {
+	configurations := make([]objectBucketOptionalConfiguration, 0, len(allConfigurations))
+	for _, configuration := range allConfigurations {
+		if _, ok := d.GetOk(configuration.key); ok {
+			configurations = append(configurations, configuration)
+		}
+	}
+
+	values := make([]interface{}, len(configurations))
+	errs := make([]error, len(configurations))
+
+	wg := sync.WaitGroup{}
+	for i := range configurations {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			values[i], errs[i] = configurations[i].read()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, configuration := range configurations {
+		var err error
+		switch {
+		case isS3Err(errs[i], ErrCodeNotImplemented, ""):
+			tflog.Warn(ctx, fmt.Sprintf("%s is not supported by the object storage of bucket %s, keeping the configured value", configuration.key, d.Id()))
+		case configuration.notFoundCode != "" && isS3Err(errs[i], configuration.notFoundCode, ""):
+			err = d.Set(configuration.key, nil)
+		case errs[i] != nil:
+			err = fmt.Errorf("couldn't read %s from bucket: %s", configuration.key, errs[i])
+		default:
+			err = d.Set(configuration.key, values[i])
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// objectBucketOptionalConfigurationDiags returns the outcome of the update of a bucket configuration that the object
+// storage does not implement in every region: where it is not implemented, the configuration is ignored with a warning.
+func objectBucketOptionalConfigurationDiags(key string, region scw.Region, err error) diag.Diagnostics {
+	if isS3Err(err, ErrCodeNotImplemented, "") {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s is not supported by the object storage of region %s, it is ignored", key, region),
+		}}
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error putting S3 %s: %s", key, err))
+	}
+
+	return nil
+}
+
 func flattenObjectBucketVersioning(versioningResponse *s3.GetBucketVersioningOutput) []map[string]interface{} {
 	vcl := []map[string]interface{}{{}}
 	vcl[0]["enabled"] = versioningResponse.Status != nil && *versioningResponse.Status == s3.BucketVersioningStatusEnabled
@@ -148,6 +225,82 @@ func expandObjectBucketVersioning(v []interface{}) *s3.VersioningConfiguration {
 	return vc
 }
 
+func expandObjectBucketReplication(v []interface{}) *s3.ReplicationConfiguration {
+	raw := v[0].(map[string]interface{})
+	rc := &s3.ReplicationConfiguration{
+		Role: expandStringPtr(raw["role"]),
+	}
+
+	for _, rawRule := range raw["rule"].([]interface{}) {
+		r := rawRule.(map[string]interface{})
+		rule := &s3.ReplicationRule{
+			ID:     expandStringPtr(r["id"]),
+			Prefix: scw.StringPtr(r["prefix"].(string)),
+			Status: expandStringPtr(r["status"]),
+		}
+
+		if destinations := r["destination"].([]interface{}); len(destinations) > 0 {
+			destination := destinations[0].(map[string]interface{})
+			rule.Destination = &s3.Destination{
+				Bucket:       scw.StringPtr(objectBucketARNPrefix + expandID(destination["bucket"])),
+				StorageClass: expandStringPtr(destination["storage_class"]),
+			}
+		}
+
+		rc.Rules = append(rc.Rules, rule)
+	}
+
+	return rc
+}
+
+// flattenObjectBucketReplication flattens the replication configuration.
+// The API only returns the destination bucket names, so the destination from the state is kept when it targets the same bucket.
+func flattenObjectBucketReplication(rc *s3.ReplicationConfiguration, current []interface{}) []map[string]interface{} {
+	if rc == nil || len(rc.Rules) == 0 {
+		return nil
+	}
+
+	currentDestinations := map[string]string{}
+	if len(current) > 0 && current[0] != nil {
+		for _, rawRule := range current[0].(map[string]interface{})["rule"].([]interface{}) {
+			for _, rawDestination := range rawRule.(map[string]interface{})["destination"].([]interface{}) {
+				bucket := rawDestination.(map[string]interface{})["bucket"].(string)
+				currentDestinations[expandID(bucket)] = bucket
+			}
+		}
+	}
+
+	rules := make([]map[string]interface{}, 0, len(rc.Rules))
+	for _, r := range rc.Rules {
+		rule := map[string]interface{}{
+			"id":     aws.StringValue(r.ID),
+			"prefix": aws.StringValue(r.Prefix),
+			"status": aws.StringValue(r.Status),
+		}
+		if r.Filter != nil && r.Filter.Prefix != nil {
+			rule["prefix"] = aws.StringValue(r.Filter.Prefix)
+		}
+
+		if r.Destination != nil {
+			bucket := strings.TrimPrefix(aws.StringValue(r.Destination.Bucket), objectBucketARNPrefix)
+			if currentBucket, ok := currentDestinations[bucket]; ok {
+				bucket = currentBucket
+			}
+			rule["destination"] = []map[string]interface{}{{
+				"bucket":        bucket,
+				"storage_class": aws.StringValue(r.Destination.StorageClass),
+			}}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return []map[string]interface{}{{
+		"role": aws.StringValue(rc.Role),
+		"rule": rules,
+	}}
+}
+
 func flattenBucketCORS(corsResponse interface{}) []map[string]interface{} {
 	corsRules := make([]map[string]interface{}, 0)
 	if cors, ok := corsResponse.(*s3.GetBucketCorsOutput); ok && len(cors.CORSRules) > 0 {
@@ -278,7 +431,7 @@ func deleteS3ObjectVersions(ctx context.Context, conn *s3.S3, bucketName string,
 		return true
 	})
 	if listErr != nil {
-		return fmt.Errorf("error listing S3 objects: %s", err)
+		return fmt.Errorf("error listing S3 objects: %s", listErr)
 	}
 	if err != nil {
 		return err
@@ -297,7 +450,7 @@ func deleteS3ObjectVersions(ctx context.Context, conn *s3.S3, bucketName string,
 		return true
 	})
 	if listErr != nil {
-		return fmt.Errorf("error listing S3 objects for delete markers: %s", err)
+		return fmt.Errorf("error listing S3 objects for delete markers: %s", listErr)
 	}
 	if err != nil {
 		return err
@@ -305,6 +458,32 @@ func deleteS3ObjectVersions(ctx context.Context, conn *s3.S3, bucketName string,
 	return nil
 }
 
+// abortS3MultipartUploads aborts the incomplete multipart uploads of a bucket, their parts prevent its deletion
+func abortS3MultipartUploads(ctx context.Context, conn *s3.S3, bucketName string) error {
+	var err error
+	listErr := conn.ListMultipartUploadsPagesWithContext(ctx, &s3.ListMultipartUploadsInput{
+		Bucket: scw.StringPtr(bucketName),
+	}, func(page *s3.ListMultipartUploadsOutput, lastPage bool) bool {
+		for _, upload := range page.Uploads {
+			_, err = conn.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+				Bucket:   scw.StringPtr(bucketName),
+				Key:      upload.Key,
+				UploadId: upload.UploadId,
+			})
+			if err != nil && !isS3Err(err, s3.ErrCodeNoSuchUpload, "") {
+				err = fmt.Errorf("failed to abort S3 multipart upload %s: %s", aws.StringValue(upload.Key), err)
+				return false
+			}
+			err = nil
+		}
+		return true
+	})
+	if listErr != nil {
+		return fmt.Errorf("error listing S3 multipart uploads: %s", listErr)
+	}
+	return err
+}
+
 func transitionHash(v interface{}) int {
 	var buf bytes.Buffer
 	m, ok := v.(map[string]interface{})
@@ -316,6 +495,9 @@ func transitionHash(v interface{}) int {
 	if v, ok := m["days"]; ok {
 		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
 	}
+	if v, ok := m["date"]; ok && v.(string) != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
 	if v, ok := m["storage_class"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 	}
@@ -379,3 +561,401 @@ func TransitionSCWStorageClassValues() []string {
 		TransitionStorageClassOnezoneIa,
 	}
 }
+
+// validateObjectStorageClassRegion checks that the storage class is available in the region of the bucket
+func validateObjectStorageClassRegion(storageClass string, region scw.Region) error {
+	switch {
+	case storageClass == TransitionStorageClassGlacier && region == scw.RegionPlWaw:
+		return fmt.Errorf("storage class %s is not available in region %s", storageClass, region)
+	case storageClass == TransitionStorageClassOnezoneIa && region != scw.RegionFrPar:
+		return fmt.Errorf("storage class %s is only available in region %s", storageClass, scw.RegionFrPar)
+	}
+	return nil
+}
+
+func expandObjectBucketPublicAccessBlock(raw []interface{}) *s3.PublicAccessBlockConfiguration {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+
+	block := raw[0].(map[string]interface{})
+	return &s3.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       aws.Bool(block["block_public_acls"].(bool)),
+		BlockPublicPolicy:     aws.Bool(block["block_public_policy"].(bool)),
+		IgnorePublicAcls:      aws.Bool(block["ignore_public_acls"].(bool)),
+		RestrictPublicBuckets: aws.Bool(block["restrict_public_buckets"].(bool)),
+	}
+}
+
+func flattenObjectBucketPublicAccessBlock(block *s3.PublicAccessBlockConfiguration) []map[string]interface{} {
+	if block == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"block_public_acls":       aws.BoolValue(block.BlockPublicAcls),
+		"block_public_policy":     aws.BoolValue(block.BlockPublicPolicy),
+		"ignore_public_acls":      aws.BoolValue(block.IgnorePublicAcls),
+		"restrict_public_buckets": aws.BoolValue(block.RestrictPublicBuckets),
+	}}
+}
+
+// flattenObjectBucketOwnership returns the object ownership of the first rule, the only one supported by S3
+func flattenObjectBucketOwnership(controls *s3.OwnershipControls) string {
+	if controls == nil {
+		return ""
+	}
+	for _, rule := range controls.Rules {
+		if rule != nil {
+			return aws.StringValue(rule.ObjectOwnership)
+		}
+	}
+
+	return ""
+}
+
+// objectBucketConfigurationSchema returns the schema of the bucket configurations identified by an ID and filtered by prefix,
+// such as the metrics and analytics configurations.
+func objectBucketConfigurationSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+					Description:  "Unique identifier of the configuration",
+				},
+				"prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Only the objects with this key prefix are taken into account",
+				},
+			},
+		},
+	}
+}
+
+// objectBucketConfigurationsToDelete returns the IDs of the old configurations missing from the new ones
+func objectBucketConfigurationsToDelete(oldConfigurations, newConfigurations []interface{}) []string {
+	kept := map[string]bool{}
+	for _, raw := range newConfigurations {
+		kept[raw.(map[string]interface{})["id"].(string)] = true
+	}
+
+	ids := []string(nil)
+	for _, raw := range oldConfigurations {
+		id := raw.(map[string]interface{})["id"].(string)
+		if !kept[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func expandObjectBucketMetricsConfiguration(raw interface{}) *s3.MetricsConfiguration {
+	r := raw.(map[string]interface{})
+	configuration := &s3.MetricsConfiguration{
+		Id: aws.String(r["id"].(string)),
+	}
+	if prefix := r["prefix"].(string); prefix != "" {
+		configuration.Filter = &s3.MetricsFilter{Prefix: aws.String(prefix)}
+	}
+
+	return configuration
+}
+
+func flattenObjectBucketMetricsConfigurations(configurations []*s3.MetricsConfiguration) []map[string]interface{} {
+	flat := []map[string]interface{}(nil)
+	for _, configuration := range configurations {
+		prefix := ""
+		if configuration.Filter != nil {
+			prefix = aws.StringValue(configuration.Filter.Prefix)
+		}
+		flat = append(flat, map[string]interface{}{
+			"id":     aws.StringValue(configuration.Id),
+			"prefix": prefix,
+		})
+	}
+
+	return flat
+}
+
+func expandObjectBucketAnalyticsConfiguration(raw interface{}) *s3.AnalyticsConfiguration {
+	r := raw.(map[string]interface{})
+	configuration := &s3.AnalyticsConfiguration{
+		Id:                   aws.String(r["id"].(string)),
+		StorageClassAnalysis: &s3.StorageClassAnalysis{},
+	}
+	if prefix := r["prefix"].(string); prefix != "" {
+		configuration.Filter = &s3.AnalyticsFilter{Prefix: aws.String(prefix)}
+	}
+
+	return configuration
+}
+
+func flattenObjectBucketAnalyticsConfigurations(configurations []*s3.AnalyticsConfiguration) []map[string]interface{} {
+	flat := []map[string]interface{}(nil)
+	for _, configuration := range configurations {
+		prefix := ""
+		if configuration.Filter != nil {
+			prefix = aws.StringValue(configuration.Filter.Prefix)
+		}
+		flat = append(flat, map[string]interface{}{
+			"id":     aws.StringValue(configuration.Id),
+			"prefix": prefix,
+		})
+	}
+
+	return flat
+}
+
+// objectBucketWebsiteSchema returns the schema of the website configuration of a bucket
+func objectBucketWebsiteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Serve the bucket as a static website",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"index_document": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The suffix appended to the requests for a directory, e.g. index.html",
+				},
+				"error_document": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The key of the object returned on 4xx errors",
+				},
+				"routing_rule": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The redirect rules of the website, evaluated in order",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								MaxItems:    1,
+								Description: "The condition to apply the redirect, the redirect applies to every request without condition",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"key_prefix_equals": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The key prefix of the requests to redirect",
+										},
+										"http_error_code_returned_equals": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[45]\d\d$`), "must be a 4xx or 5xx HTTP status code"),
+											Description:  "The HTTP error code of the requests to redirect",
+										},
+									},
+								},
+							},
+							"redirect": {
+								Type:        schema.TypeList,
+								Required:    true,
+								MaxItems:    1,
+								Description: "Where to redirect the requests",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"host_name": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The host name to redirect to",
+										},
+										"http_redirect_code": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringMatch(regexp.MustCompile(`^3\d\d$`), "must be a 3xx HTTP status code"),
+											Description:  "The HTTP status code of the redirect",
+										},
+										"protocol": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{s3.ProtocolHttp, s3.ProtocolHttps}, false),
+											Description:  "The protocol to redirect to, http or https",
+										},
+										"replace_key_prefix_with": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The key prefix replacing key_prefix_equals in the redirect",
+										},
+										"replace_key_with": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The key replacing the whole key of the request in the redirect",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateObjectBucketWebsiteRoutingRules checks that each routing rule redirects somewhere,
+// and that the key is replaced either as a whole or by prefix.
+func validateObjectBucketWebsiteRoutingRules(rawRules []interface{}) error {
+	for i, rawRule := range rawRules {
+		rule, _ := rawRule.(map[string]interface{})
+		rawRedirect, _ := rule["redirect"].([]interface{})
+		if len(rawRedirect) == 0 || rawRedirect[0] == nil {
+			return fmt.Errorf("routing_rule %d: redirect must set at least one of host_name, http_redirect_code, protocol, replace_key_prefix_with or replace_key_with", i)
+		}
+		redirect := rawRedirect[0].(map[string]interface{})
+
+		if redirect["replace_key_prefix_with"] != "" && redirect["replace_key_with"] != "" {
+			return fmt.Errorf("routing_rule %d: redirect cannot set both replace_key_prefix_with and replace_key_with", i)
+		}
+
+		rawCondition, _ := rule["condition"].([]interface{})
+		if len(rawCondition) > 0 {
+			condition, _ := rawCondition[0].(map[string]interface{})
+			if condition == nil || (condition["key_prefix_equals"] == "" && condition["http_error_code_returned_equals"] == "") {
+				return fmt.Errorf("routing_rule %d: condition must set key_prefix_equals or http_error_code_returned_equals", i)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandObjectBucketWebsite(raw []interface{}) *s3.WebsiteConfiguration {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	website := raw[0].(map[string]interface{})
+
+	config := &s3.WebsiteConfiguration{
+		IndexDocument: &s3.IndexDocument{Suffix: expandStringPtr(website["index_document"])},
+	}
+	if errorDocument := website["error_document"].(string); errorDocument != "" {
+		config.ErrorDocument = &s3.ErrorDocument{Key: scw.StringPtr(errorDocument)}
+	}
+
+	for _, rawRule := range website["routing_rule"].([]interface{}) {
+		rule := rawRule.(map[string]interface{})
+		routingRule := &s3.RoutingRule{Redirect: &s3.Redirect{}}
+
+		if rawRedirect := rule["redirect"].([]interface{}); len(rawRedirect) > 0 && rawRedirect[0] != nil {
+			redirect := rawRedirect[0].(map[string]interface{})
+			routingRule.Redirect = &s3.Redirect{
+				HostName:             expandStringPtr(redirect["host_name"]),
+				HttpRedirectCode:     expandStringPtr(redirect["http_redirect_code"]),
+				Protocol:             expandStringPtr(redirect["protocol"]),
+				ReplaceKeyPrefixWith: expandStringPtr(redirect["replace_key_prefix_with"]),
+				ReplaceKeyWith:       expandStringPtr(redirect["replace_key_with"]),
+			}
+		}
+
+		if rawCondition := rule["condition"].([]interface{}); len(rawCondition) > 0 && rawCondition[0] != nil {
+			condition := rawCondition[0].(map[string]interface{})
+			routingRule.Condition = &s3.Condition{
+				KeyPrefixEquals:             expandStringPtr(condition["key_prefix_equals"]),
+				HttpErrorCodeReturnedEquals: expandStringPtr(condition["http_error_code_returned_equals"]),
+			}
+		}
+
+		config.RoutingRules = append(config.RoutingRules, routingRule)
+	}
+
+	return config
+}
+
+// flattenObjectBucketWebsite flattens the website configuration of a bucket, routing rules are kept in the order returned by the API.
+func flattenObjectBucketWebsite(website *s3.GetBucketWebsiteOutput) []map[string]interface{} {
+	if website == nil || website.IndexDocument == nil {
+		return nil
+	}
+
+	rules := make([]map[string]interface{}, 0, len(website.RoutingRules))
+	for _, routingRule := range website.RoutingRules {
+		rule := map[string]interface{}{}
+		if routingRule.Condition != nil {
+			rule["condition"] = []map[string]interface{}{{
+				"key_prefix_equals":               aws.StringValue(routingRule.Condition.KeyPrefixEquals),
+				"http_error_code_returned_equals": aws.StringValue(routingRule.Condition.HttpErrorCodeReturnedEquals),
+			}}
+		}
+		if routingRule.Redirect != nil {
+			rule["redirect"] = []map[string]interface{}{{
+				"host_name":               aws.StringValue(routingRule.Redirect.HostName),
+				"http_redirect_code":      aws.StringValue(routingRule.Redirect.HttpRedirectCode),
+				"protocol":                aws.StringValue(routingRule.Redirect.Protocol),
+				"replace_key_prefix_with": aws.StringValue(routingRule.Redirect.ReplaceKeyPrefixWith),
+				"replace_key_with":        aws.StringValue(routingRule.Redirect.ReplaceKeyWith),
+			}}
+		}
+		rules = append(rules, rule)
+	}
+
+	errorDocument := ""
+	if website.ErrorDocument != nil {
+		errorDocument = aws.StringValue(website.ErrorDocument.Key)
+	}
+
+	return []map[string]interface{}{{
+		"index_document": aws.StringValue(website.IndexDocument.Suffix),
+		"error_document": errorDocument,
+		"routing_rule":   rules,
+	}}
+}
+
+// objectBucketDiffRegion returns the region of a planned bucket, which defaults to the region of the provider.
+// It returns false when the region is not known yet.
+func objectBucketDiffRegion(diff *schema.ResourceDiff, meta interface{}) (scw.Region, bool) {
+	if !diff.NewValueKnown("region") {
+		return "", false
+	}
+	if rawRegion, ok := diff.GetOk("region"); ok {
+		return scw.Region(rawRegion.(string)), true
+	}
+	return meta.(*Meta).scwClient.GetDefaultRegion()
+}
+
+// validateObjectBucketLifecycleTransitionTriggers checks in the raw configuration of a bucket
+// that no lifecycle transition sets both days and date.
+func validateObjectBucketLifecycleTransitionTriggers(rawConfig cty.Value) error {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return nil
+	}
+
+	rules := rawConfig.GetAttr("lifecycle_rule")
+	if !rules.IsKnown() || rules.IsNull() {
+		return nil
+	}
+	for rulesIt := rules.ElementIterator(); rulesIt.Next(); {
+		index, rule := rulesIt.Element()
+		if !rule.IsKnown() || rule.IsNull() {
+			continue
+		}
+		transitions := rule.GetAttr("transition")
+		if !transitions.IsKnown() || transitions.IsNull() {
+			continue
+		}
+		for transitionsIt := transitions.ElementIterator(); transitionsIt.Next(); {
+			_, transition := transitionsIt.Element()
+			if !transition.IsKnown() || transition.IsNull() {
+				continue
+			}
+			if !transition.GetAttr("days").IsNull() && !transition.GetAttr("date").IsNull() {
+				ruleIndex, _ := index.AsBigFloat().Int64()
+				return fmt.Errorf("lifecycle_rule.%d: a transition can't have both days and date", ruleIndex)
+			}
+		}
+	}
+
+	return nil
+}
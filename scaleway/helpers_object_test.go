@@ -1,9 +1,14 @@
 package scaleway
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/stretchr/testify/assert"
 )
@@ -48,3 +53,225 @@ func TestExpandObjectBucketTags(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenObjectBucketReplication(t *testing.T) {
+	current := []interface{}{map[string]interface{}{
+		"role": "replication",
+		"rule": []interface{}{map[string]interface{}{
+			"id":     "all",
+			"prefix": "",
+			"status": "Enabled",
+			"destination": []interface{}{map[string]interface{}{
+				"bucket":        "nl-ams/destination",
+				"storage_class": "",
+			}},
+		}},
+	}}
+
+	// the API only returns the destination name, the regional ID from the state is kept
+	flattened := flattenObjectBucketReplication(expandObjectBucketReplication(current), current)
+	assert.Equal(t, "arn:aws:s3:::destination", *expandObjectBucketReplication(current).Rules[0].Destination.Bucket)
+	assert.Equal(t, "nl-ams/destination", flattened[0]["rule"].([]map[string]interface{})[0]["destination"].([]map[string]interface{})[0]["bucket"])
+
+	// an unknown destination is read as a bucket name
+	flattened = flattenObjectBucketReplication(expandObjectBucketReplication(current), nil)
+	assert.Equal(t, "destination", flattened[0]["rule"].([]map[string]interface{})[0]["destination"].([]map[string]interface{})[0]["bucket"])
+
+	assert.Nil(t, flattenObjectBucketReplication(nil, current))
+}
+
+func TestValidateObjectStorageClassRegion(t *testing.T) {
+	assert.NoError(t, validateObjectStorageClassRegion(TransitionStorageClassGlacier, scw.RegionFrPar))
+	assert.NoError(t, validateObjectStorageClassRegion(TransitionStorageClassGlacier, scw.RegionNlAms))
+	assert.Error(t, validateObjectStorageClassRegion(TransitionStorageClassGlacier, scw.RegionPlWaw))
+	assert.NoError(t, validateObjectStorageClassRegion(TransitionStorageClassOnezoneIa, scw.RegionFrPar))
+	assert.Error(t, validateObjectStorageClassRegion(TransitionStorageClassOnezoneIa, scw.RegionNlAms))
+	assert.NoError(t, validateObjectStorageClassRegion(TransitionStorageClassStandard, scw.RegionPlWaw))
+}
+
+func TestObjectBucketPublicAccessBlock(t *testing.T) {
+	assert.Nil(t, expandObjectBucketPublicAccessBlock(nil))
+	assert.Nil(t, flattenObjectBucketPublicAccessBlock(nil))
+
+	raw := []interface{}{map[string]interface{}{
+		"block_public_acls":       true,
+		"block_public_policy":     false,
+		"ignore_public_acls":      true,
+		"restrict_public_buckets": false,
+	}}
+	block := expandObjectBucketPublicAccessBlock(raw)
+	assert.Equal(t, &s3.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       aws.Bool(true),
+		BlockPublicPolicy:     aws.Bool(false),
+		IgnorePublicAcls:      aws.Bool(true),
+		RestrictPublicBuckets: aws.Bool(false),
+	}, block)
+	assert.Equal(t, []map[string]interface{}{raw[0].(map[string]interface{})}, flattenObjectBucketPublicAccessBlock(block))
+}
+
+func TestFlattenObjectBucketOwnership(t *testing.T) {
+	assert.Equal(t, "", flattenObjectBucketOwnership(nil))
+	assert.Equal(t, "", flattenObjectBucketOwnership(&s3.OwnershipControls{}))
+	assert.Equal(t, s3.ObjectOwnershipBucketOwnerEnforced, flattenObjectBucketOwnership(&s3.OwnershipControls{
+		Rules: []*s3.OwnershipControlsRule{{ObjectOwnership: aws.String(s3.ObjectOwnershipBucketOwnerEnforced)}},
+	}))
+}
+
+func TestObjectBucketConfigurationsToDelete(t *testing.T) {
+	oldConfigurations := []interface{}{
+		map[string]interface{}{"id": "all", "prefix": ""},
+		map[string]interface{}{"id": "logs", "prefix": "logs/"},
+	}
+	newConfigurations := []interface{}{
+		map[string]interface{}{"id": "logs", "prefix": "logs/2022/"},
+		map[string]interface{}{"id": "images", "prefix": "images/"},
+	}
+
+	assert.Equal(t, []string{"all"}, objectBucketConfigurationsToDelete(oldConfigurations, newConfigurations))
+	assert.Empty(t, objectBucketConfigurationsToDelete(nil, newConfigurations))
+	assert.Equal(t, []string{"all", "logs"}, objectBucketConfigurationsToDelete(oldConfigurations, nil))
+}
+
+func TestObjectBucketMetricsAndAnalyticsConfigurations(t *testing.T) {
+	all := map[string]interface{}{"id": "all", "prefix": ""}
+	logs := map[string]interface{}{"id": "logs", "prefix": "logs/"}
+
+	metrics := []*s3.MetricsConfiguration{expandObjectBucketMetricsConfiguration(all), expandObjectBucketMetricsConfiguration(logs)}
+	assert.Nil(t, metrics[0].Filter)
+	assert.Equal(t, "logs/", aws.StringValue(metrics[1].Filter.Prefix))
+	assert.Equal(t, []map[string]interface{}{all, logs}, flattenObjectBucketMetricsConfigurations(metrics))
+
+	analytics := []*s3.AnalyticsConfiguration{expandObjectBucketAnalyticsConfiguration(all), expandObjectBucketAnalyticsConfiguration(logs)}
+	assert.NotNil(t, analytics[0].StorageClassAnalysis)
+	assert.Nil(t, analytics[0].Filter)
+	assert.Equal(t, []map[string]interface{}{all, logs}, flattenObjectBucketAnalyticsConfigurations(analytics))
+}
+
+func TestValidateObjectBucketWebsiteRoutingRules(t *testing.T) {
+	redirect := func(fields map[string]interface{}) []interface{} {
+		r := map[string]interface{}{
+			"host_name":               "",
+			"http_redirect_code":      "",
+			"protocol":                "",
+			"replace_key_prefix_with": "",
+			"replace_key_with":        "",
+		}
+		for k, v := range fields {
+			r[k] = v
+		}
+		return []interface{}{r}
+	}
+
+	assert.NoError(t, validateObjectBucketWebsiteRoutingRules(nil))
+	assert.NoError(t, validateObjectBucketWebsiteRoutingRules([]interface{}{
+		map[string]interface{}{
+			"condition": []interface{}{map[string]interface{}{"key_prefix_equals": "docs/", "http_error_code_returned_equals": ""}},
+			"redirect":  redirect(map[string]interface{}{"replace_key_prefix_with": "documents/"}),
+		},
+		map[string]interface{}{
+			"condition": []interface{}{},
+			"redirect":  redirect(map[string]interface{}{"host_name": "example.com", "protocol": "https"}),
+		},
+	}))
+
+	assert.EqualError(t, validateObjectBucketWebsiteRoutingRules([]interface{}{
+		map[string]interface{}{
+			"redirect": []interface{}{nil},
+		},
+	}), "routing_rule 0: redirect must set at least one of host_name, http_redirect_code, protocol, replace_key_prefix_with or replace_key_with")
+
+	assert.EqualError(t, validateObjectBucketWebsiteRoutingRules([]interface{}{
+		map[string]interface{}{
+			"redirect": redirect(map[string]interface{}{"replace_key_prefix_with": "a/", "replace_key_with": "b"}),
+		},
+	}), "routing_rule 0: redirect cannot set both replace_key_prefix_with and replace_key_with")
+
+	assert.EqualError(t, validateObjectBucketWebsiteRoutingRules([]interface{}{
+		map[string]interface{}{
+			"condition": []interface{}{nil},
+			"redirect":  redirect(map[string]interface{}{"host_name": "example.com"}),
+		},
+	}), "routing_rule 0: condition must set key_prefix_equals or http_error_code_returned_equals")
+}
+
+func TestObjectBucketWebsite(t *testing.T) {
+	assert.Nil(t, expandObjectBucketWebsite(nil))
+	assert.Nil(t, flattenObjectBucketWebsite(&s3.GetBucketWebsiteOutput{}))
+
+	raw := []interface{}{map[string]interface{}{
+		"index_document": "index.html",
+		"error_document": "error.html",
+		"routing_rule": []interface{}{
+			map[string]interface{}{
+				"condition": []interface{}{map[string]interface{}{"key_prefix_equals": "", "http_error_code_returned_equals": "404"}},
+				"redirect": []interface{}{map[string]interface{}{
+					"host_name":               "example.com",
+					"http_redirect_code":      "302",
+					"protocol":                "https",
+					"replace_key_prefix_with": "",
+					"replace_key_with":        "",
+				}},
+			},
+		},
+	}}
+
+	website := expandObjectBucketWebsite(raw)
+	assert.Equal(t, &s3.WebsiteConfiguration{
+		IndexDocument: &s3.IndexDocument{Suffix: scw.StringPtr("index.html")},
+		ErrorDocument: &s3.ErrorDocument{Key: scw.StringPtr("error.html")},
+		RoutingRules: []*s3.RoutingRule{{
+			Condition: &s3.Condition{HttpErrorCodeReturnedEquals: scw.StringPtr("404")},
+			Redirect: &s3.Redirect{
+				HostName:         scw.StringPtr("example.com"),
+				HttpRedirectCode: scw.StringPtr("302"),
+				Protocol:         scw.StringPtr("https"),
+			},
+		}},
+	}, website)
+
+	flattened := flattenObjectBucketWebsite(&s3.GetBucketWebsiteOutput{
+		IndexDocument: website.IndexDocument,
+		ErrorDocument: website.ErrorDocument,
+		RoutingRules:  website.RoutingRules,
+	})
+	assert.Equal(t, "index.html", flattened[0]["index_document"])
+	assert.Equal(t, "error.html", flattened[0]["error_document"])
+	rules := flattened[0]["routing_rule"].([]map[string]interface{})
+	assert.Len(t, rules, 1)
+	assert.Equal(t, "404", rules[0]["condition"].([]map[string]interface{})[0]["http_error_code_returned_equals"])
+	assert.Equal(t, "example.com", rules[0]["redirect"].([]map[string]interface{})[0]["host_name"])
+}
+
+func TestObjectBucketOptionalConfigurationDiags(t *testing.T) {
+	assert.Nil(t, objectBucketOptionalConfigurationDiags("request_payer", scw.RegionFrPar, nil))
+
+	diags := objectBucketOptionalConfigurationDiags("request_payer", scw.RegionFrPar, awserr.New(ErrCodeNotImplemented, "not implemented", nil))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+
+	diags = objectBucketOptionalConfigurationDiags("request_payer", scw.RegionFrPar, errors.New("internal error"))
+	assert.True(t, diags.HasError())
+}
+
+func TestValidateObjectBucketLifecycleTransitionTriggers(t *testing.T) {
+	config := func(days cty.Value, date cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"lifecycle_rule": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"transition": cty.SetVal([]cty.Value{
+						cty.ObjectVal(map[string]cty.Value{
+							"days":          days,
+							"date":          date,
+							"storage_class": cty.StringVal(TransitionStorageClassGlacier),
+						}),
+					}),
+				}),
+			}),
+		})
+	}
+
+	assert.NoError(t, validateObjectBucketLifecycleTransitionTriggers(config(cty.NumberIntVal(0), cty.NullVal(cty.String))))
+	assert.NoError(t, validateObjectBucketLifecycleTransitionTriggers(config(cty.NullVal(cty.Number), cty.StringVal("2030-01-01T00:00:00Z"))))
+	assert.Error(t, validateObjectBucketLifecycleTransitionTriggers(config(cty.NumberIntVal(0), cty.StringVal("2030-01-01T00:00:00Z"))))
+	assert.NoError(t, validateObjectBucketLifecycleTransitionTriggers(cty.NullVal(cty.DynamicPseudoType)))
+}
@@ -2,7 +2,10 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,8 +15,20 @@ import (
 
 const (
 	defaultRdbInstanceTimeout = 15 * time.Minute
+
+	rdbConflictMaxAttempts    = 10
+	rdbConflictBaseRetryDelay = time.Second
+	rdbConflictMaxRetryDelay  = 30 * time.Second
 )
 
+// rdbInstanceMutexKV serializes the mutations made on the same instance by the users, databases, ACLs...
+// as the instance refuses concurrent mutations (409) while it applies one.
+var rdbInstanceMutexKV = newMutexKV()
+
+func rdbInstanceMutexKey(region scw.Region, instanceID string) string {
+	return newRegionalIDString(region, instanceID)
+}
+
 // newRdbAPI returns a new RDB API
 func newRdbAPI(m interface{}) *rdb.API {
 	meta := m.(*Meta)
@@ -74,6 +89,30 @@ func expandInstanceSettings(i interface{}) []*rdb.InstanceSetting {
 	return res
 }
 
+// diffSuppressFuncRdbInstanceSettings ignores the settings returned by the API that are not in the configuration,
+// mostly engine defaults, so that only the settings managed by terraform are diffed.
+func diffSuppressFuncRdbInstanceSettings(k, _, _ string, d *schema.ResourceData) bool {
+	oldSettings, newSettings := d.GetChange("settings")
+	return isRdbInstanceSettingUnmanaged(k, oldSettings.(map[string]interface{}), newSettings.(map[string]interface{}))
+}
+
+// isRdbInstanceSettingUnmanaged reports whether the diff of the key k of the settings map only comes from settings missing from newSettings.
+// The count of the map (settings.%) differs as soon as the API returns a setting that is not configured, so it is ignored
+// as long as every configured setting is unchanged.
+func isRdbInstanceSettingUnmanaged(k string, oldSettings, newSettings map[string]interface{}) bool {
+	if k == "settings.%" {
+		for name, value := range newSettings {
+			if oldValue, ok := oldSettings[name]; !ok || oldValue != value {
+				return false
+			}
+		}
+		return true
+	}
+
+	_, managed := newSettings[strings.TrimPrefix(k, "settings.")]
+	return !managed
+}
+
 func waitForRDBInstance(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (*rdb.Instance, error) {
 	retryInterval := defaultWaitRDBRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -88,6 +127,99 @@ func waitForRDBInstance(ctx context.Context, api *rdb.API, region scw.Region, id
 	}, scw.WithContext(ctx))
 }
 
+func waitForRDBDatabaseBackup(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (*rdb.DatabaseBackup, error) {
+	retryInterval := defaultWaitRDBRetryInterval
+	if DefaultWaitRetryInterval != nil {
+		retryInterval = *DefaultWaitRetryInterval
+	}
+
+	return api.WaitForDatabaseBackup(&rdb.WaitForDatabaseBackupRequest{
+		Region:           region,
+		Timeout:          scw.TimeDurationPtr(timeout),
+		DatabaseBackupID: id,
+		RetryInterval:    &retryInterval,
+	}, scw.WithContext(ctx))
+}
+
+// waitForRDBDatabaseBackupReady waits for the backup and fails if it ended in error.
+func waitForRDBDatabaseBackupReady(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) error {
+	backup, err := waitForRDBDatabaseBackup(ctx, api, region, id, timeout)
+	if err != nil {
+		return err
+	}
+	if backup.Status != rdb.DatabaseBackupStatusReady {
+		return fmt.Errorf("database backup %s is in status %s", id, backup.Status)
+	}
+
+	return nil
+}
+
+// waitForRDBInstanceIfTransient only waits for the instance when it is in a transient status,
+// which saves the wait loop when the instance is already in a terminal status.
+func waitForRDBInstanceIfTransient(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (*rdb.Instance, error) {
+	instance, err := api.GetInstance(&rdb.GetInstanceRequest{
+		Region:     region,
+		InstanceID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	switch instance.Status {
+	case rdb.InstanceStatusReady, rdb.InstanceStatusDiskFull, rdb.InstanceStatusError:
+		return instance, nil
+	}
+
+	return waitForRDBInstance(ctx, api, region, id, timeout)
+}
+
+// rdbRetryOnConflict calls f until it does not fail with a conflict error.
+// An instance refuses mutations (409) while it is not ready, so it is waited for between attempts,
+// then a jittered exponential backoff spreads the retries of concurrent mutations.
+// It gives up after rdbConflictMaxAttempts attempts or when timeout is reached.
+func rdbRetryOnConflict(ctx context.Context, api *rdb.API, region scw.Region, instanceID string, timeout time.Duration, f func() error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	attempt := 0
+	for {
+		attempt++
+		err := f()
+		if err == nil || !is409Error(err) {
+			return err
+		}
+		if attempt == rdbConflictMaxAttempts {
+			return fmt.Errorf("instance %s still in conflict after %d attempts: %w", instanceID, attempt, err)
+		}
+
+		_, errWait := waitForRDBInstance(ctx, api, region, instanceID, timeout)
+		if errWait != nil {
+			return errWait
+		}
+
+		delay := rdbConflictRetryDelay(attempt, rand.Float64()) //nolint:gosec // The jitter does not need a secure random source
+		if DefaultWaitRetryInterval != nil {
+			delay = *DefaultWaitRetryInterval
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("instance %s still in conflict after %d attempts: %w", instanceID, attempt, err)
+		case <-time.After(delay):
+		}
+	}
+}
+
+// rdbConflictRetryDelay returns the delay before the next attempt, doubling from rdbConflictBaseRetryDelay
+// up to rdbConflictMaxRetryDelay. random, in [0, 1), removes up to half of it so that concurrent retries spread out.
+func rdbConflictRetryDelay(attempt int, random float64) time.Duration {
+	delay := rdbConflictMaxRetryDelay
+	if attempt < 32 && rdbConflictBaseRetryDelay<<(attempt-1) < rdbConflictMaxRetryDelay {
+		delay = rdbConflictBaseRetryDelay << (attempt - 1)
+	}
+
+	return delay/2 + time.Duration(random*float64(delay/2))
+}
+
 func expandPrivateNetwork(data interface{}, exist bool) ([]*rdb.EndpointSpec, error) {
 	if data == nil || !exist {
 		return nil, nil
@@ -1,8 +1,11 @@
 package scaleway
 
 import (
+	"context"
 	"net"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -118,3 +121,65 @@ func TestEndpointsToRemove(t *testing.T) {
 		})
 	}
 }
+
+func TestRdbACLRulesFlatten(t *testing.T) {
+	mustParseIPNet := func(cidr string) scw.IPNet {
+		ipNet, err := expandIPNet(cidr)
+		assert.NoError(t, err)
+		return ipNet
+	}
+
+	rules := []*rdb.ACLRule{
+		{IP: mustParseIPNet("10.0.0.0/16"), Description: "c"},
+		{IP: mustParseIPNet("4.5.6.7/32"), Description: "b"},
+		{IP: mustParseIPNet("10.0.0.0/8"), Description: "d"},
+		{IP: mustParseIPNet("1.2.3.4/32"), Description: "a"},
+	}
+
+	assert.Equal(t, []map[string]interface{}{
+		{"ip": "1.2.3.4/32", "description": "a"},
+		{"ip": "4.5.6.7/32", "description": "b"},
+		{"ip": "10.0.0.0/8", "description": "d"},
+		{"ip": "10.0.0.0/16", "description": "c"},
+	}, rdbACLRulesFlatten(rules))
+}
+
+func TestRdbConflictRetryDelay(t *testing.T) {
+	assert.Equal(t, 500*time.Millisecond, rdbConflictRetryDelay(1, 0))
+	assert.Equal(t, 750*time.Millisecond, rdbConflictRetryDelay(1, 0.5))
+	assert.Equal(t, 3*time.Second, rdbConflictRetryDelay(3, 0.5))
+	assert.Equal(t, 15*time.Second, rdbConflictRetryDelay(6, 0))
+	assert.Equal(t, 15*time.Second, rdbConflictRetryDelay(100, 0))
+}
+
+func TestRdbRetryOnConflictDoesNotRetryOtherErrors(t *testing.T) {
+	calls := 0
+	err := rdbRetryOnConflict(context.Background(), nil, scw.RegionFrPar, "11111111-1111-1111-1111-111111111111", time.Minute, func() error {
+		calls++
+		return &scw.ResponseError{StatusCode: http.StatusBadRequest}
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	err = rdbRetryOnConflict(context.Background(), nil, scw.RegionFrPar, "11111111-1111-1111-1111-111111111111", time.Minute, func() error {
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestIsRdbInstanceSettingUnmanaged(t *testing.T) {
+	state := map[string]interface{}{
+		"work_mem":        "4",
+		"max_connections": "100",
+	}
+
+	// max_connections is an engine default returned by the API
+	assert.True(t, isRdbInstanceSettingUnmanaged("settings.max_connections", state, map[string]interface{}{"work_mem": "4"}))
+	assert.True(t, isRdbInstanceSettingUnmanaged("settings.%", state, map[string]interface{}{"work_mem": "4"}))
+
+	// managed settings are diffed
+	assert.False(t, isRdbInstanceSettingUnmanaged("settings.work_mem", state, map[string]interface{}{"work_mem": "8"}))
+	assert.False(t, isRdbInstanceSettingUnmanaged("settings.%", state, map[string]interface{}{"work_mem": "8"}))
+	assert.False(t, isRdbInstanceSettingUnmanaged("settings.effective_cache_size", state, map[string]interface{}{"work_mem": "4", "effective_cache_size": "1300"}))
+	assert.False(t, isRdbInstanceSettingUnmanaged("settings.%", state, map[string]interface{}{"work_mem": "4", "effective_cache_size": "1300"}))
+}
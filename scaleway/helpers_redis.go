@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -106,3 +107,102 @@ func flattenRedisSettings(settings []*redis.ClusterSetting) interface{} {
 	}
 	return rawSettings
 }
+
+func expandRedisPrivateNetwork(i interface{}) ([]*redis.EndpointSpec, error) {
+	endpoints := []*redis.EndpointSpec(nil)
+
+	for _, rawPN := range i.([]interface{}) {
+		pn := rawPN.(map[string]interface{})
+		serviceIPs := []scw.IPNet(nil)
+		for _, rawIP := range pn["service_ips"].([]interface{}) {
+			ip, err := expandIPNet(rawIP.(string))
+			if err != nil {
+				return nil, fmt.Errorf("failed to validate service ip (%s): %w", rawIP.(string), err)
+			}
+			serviceIPs = append(serviceIPs, ip)
+		}
+		if err := validateRedisServiceIPs(serviceIPs); err != nil {
+			return nil, err
+		}
+
+		endpoints = append(endpoints, &redis.EndpointSpec{
+			PrivateNetwork: &redis.EndpointSpecPrivateNetworkSpec{
+				ID:         expandID(pn["id"]),
+				ServiceIPs: serviceIPs,
+			},
+		})
+	}
+
+	return endpoints, nil
+}
+
+// validateRedisServiceIPs checks that the service IPs of a private network endpoint are in the same subnet
+func validateRedisServiceIPs(serviceIPs []scw.IPNet) error {
+	if len(serviceIPs) == 0 {
+		return nil
+	}
+
+	subnet := net.IPNet{
+		IP:   serviceIPs[0].IP.Mask(serviceIPs[0].Mask),
+		Mask: serviceIPs[0].Mask,
+	}
+	for _, ip := range serviceIPs {
+		if !subnet.Contains(ip.IP) || ip.Mask.String() != subnet.Mask.String() {
+			return fmt.Errorf("service ip %s is not in the subnet %s of the other service ips", ip.String(), subnet.String())
+		}
+	}
+
+	return nil
+}
+
+func flattenRedisPrivateNetwork(endpoints []*redis.Endpoint) (interface{}, error) {
+	flat := []map[string]interface{}(nil)
+	for _, endpoint := range endpoints {
+		if endpoint.PrivateNetwork == nil {
+			continue
+		}
+		serviceIPs := []interface{}(nil)
+		for _, ip := range endpoint.PrivateNetwork.ServiceIPs {
+			serviceIP, err := flattenIPNet(ip)
+			if err != nil {
+				return nil, err
+			}
+			serviceIPs = append(serviceIPs, serviceIP)
+		}
+		flat = append(flat, map[string]interface{}{
+			"id":          newZonedIDString(endpoint.PrivateNetwork.Zone, endpoint.PrivateNetwork.ID),
+			"service_ips": serviceIPs,
+			"endpoint_id": endpoint.ID,
+			"port":        int(endpoint.Port),
+			"zone":        endpoint.PrivateNetwork.Zone.String(),
+		})
+	}
+	return flat, nil
+}
+
+func flattenRedisPublicNetwork(endpoints []*redis.Endpoint) interface{} {
+	flat := []map[string]interface{}(nil)
+	for _, endpoint := range endpoints {
+		if endpoint.PublicNetwork == nil {
+			continue
+		}
+		ips := []interface{}(nil)
+		for _, ip := range endpoint.IPs {
+			ips = append(ips, ip.String())
+		}
+		flat = append(flat, map[string]interface{}{
+			"id":   endpoint.ID,
+			"port": int(endpoint.Port),
+			"ips":  ips,
+		})
+	}
+	return flat
+}
+
+// validateRedisClusterSizeChange checks that a cluster is not scaled down, the migration only adds nodes
+func validateRedisClusterSizeChange(oldSize int, newSize int) error {
+	if newSize < oldSize {
+		return fmt.Errorf("cluster_size can't be decreased from %d to %d, the cluster must be recreated to remove nodes", oldSize, newSize)
+	}
+	return nil
+}
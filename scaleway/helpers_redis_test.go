@@ -0,0 +1,35 @@
+package scaleway
+
+import (
+	"net"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRedisClusterSizeChange(t *testing.T) {
+	assert.NoError(t, validateRedisClusterSizeChange(3, 3))
+	assert.NoError(t, validateRedisClusterSizeChange(3, 6))
+	assert.Error(t, validateRedisClusterSizeChange(6, 3))
+}
+
+func TestValidateRedisServiceIPs(t *testing.T) {
+	sameSubnet := []scw.IPNet{
+		{IPNet: net.IPNet{IP: net.IPv4(10, 12, 1, 1), Mask: net.CIDRMask(20, 32)}},
+		{IPNet: net.IPNet{IP: net.IPv4(10, 12, 2, 1), Mask: net.CIDRMask(20, 32)}},
+	}
+	assert.NoError(t, validateRedisServiceIPs(sameSubnet))
+
+	otherSubnet := []scw.IPNet{
+		{IPNet: net.IPNet{IP: net.IPv4(10, 12, 1, 1), Mask: net.CIDRMask(20, 32)}},
+		{IPNet: net.IPNet{IP: net.IPv4(10, 13, 1, 1), Mask: net.CIDRMask(20, 32)}},
+	}
+	assert.Error(t, validateRedisServiceIPs(otherSubnet))
+
+	otherMask := []scw.IPNet{
+		{IPNet: net.IPNet{IP: net.IPv4(10, 12, 1, 1), Mask: net.CIDRMask(20, 32)}},
+		{IPNet: net.IPNet{IP: net.IPv4(10, 12, 1, 2), Mask: net.CIDRMask(24, 32)}},
+	}
+	assert.Error(t, validateRedisServiceIPs(otherMask))
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -12,6 +13,9 @@ import (
 const (
 	defaultRegistryNamespaceTimeout       = 5 * time.Minute
 	defaultRegistryNamespaceRetryInterval = 5 * time.Second
+
+	registryVisibilityPublic  = "public"
+	registryVisibilityPrivate = "private"
 )
 
 type ErrorRegistryMessage struct {
@@ -57,3 +61,67 @@ func waitForRegistryNamespace(ctx context.Context, api *registry.API, region scw
 
 	return ns, err
 }
+
+// registryVisibilitySchema is the visibility of the registry namespace backing a serverless namespace
+func registryVisibilitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The visibility of the registry namespace, either public or private",
+		ValidateFunc: validation.StringInSlice([]string{
+			registryVisibilityPublic,
+			registryVisibilityPrivate,
+		}, false),
+	}
+}
+
+func flattenRegistryVisibility(isPublic bool) string {
+	if isPublic {
+		return registryVisibilityPublic
+	}
+	return registryVisibilityPrivate
+}
+
+// getRegistryNamespaceVisibility returns the visibility of a registry namespace, or an empty string if it does not exist.
+func getRegistryNamespaceVisibility(ctx context.Context, m interface{}, region scw.Region, id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+	api := registry.NewAPI(m.(*Meta).scwClient)
+
+	ns, err := api.GetNamespace(&registry.GetNamespaceRequest{
+		Region:      region,
+		NamespaceID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return flattenRegistryVisibility(ns.IsPublic), nil
+}
+
+// setRegistryNamespaceVisibility updates the visibility of a registry namespace and waits for it to be ready.
+func setRegistryNamespaceVisibility(ctx context.Context, m interface{}, region scw.Region, id string, visibility string, timeout time.Duration) error {
+	api := registry.NewAPI(m.(*Meta).scwClient)
+
+	_, err := waitForRegistryNamespace(ctx, api, region, id, timeout)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.UpdateNamespace(&registry.UpdateNamespaceRequest{
+		Region:      region,
+		NamespaceID: id,
+		IsPublic:    scw.BoolPtr(visibility == registryVisibilityPublic),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForRegistryNamespace(ctx, api, region, id, timeout)
+	return err
+}
@@ -149,6 +149,25 @@ func TestNewRegionalId(t *testing.T) {
 	assert.Equal(t, "fr-par/my-id", newRegionalIDString(scw.RegionFrPar, "my-id"))
 }
 
+func TestDiffSuppressFuncLocality(t *testing.T) {
+	id := "11111111-1111-1111-1111-111111111111"
+	assert.True(t, diffSuppressFuncLocality("instance_id", "fr-par/"+id, id, nil))
+	assert.True(t, diffSuppressFuncLocality("instance_id", id, "fr-par/"+id, nil))
+	assert.True(t, diffSuppressFuncLocality("server_id", "fr-par-1/"+id, "fr-par-1/"+id, nil))
+	assert.False(t, diffSuppressFuncLocality("instance_id", "fr-par/"+id, "fr-par/22222222-2222-2222-2222-222222222222", nil))
+	assert.False(t, diffSuppressFuncLocality("instance_id", "", id, nil))
+}
+
+func TestDiffSuppressFuncIPNet(t *testing.T) {
+	assert.True(t, diffSuppressFuncIPNet("ip", "192.168.1.1/32", "192.168.1.1", nil))
+	assert.True(t, diffSuppressFuncIPNet("ip", "192.168.1.0/24", "192.168.1.12/24", nil))
+	assert.True(t, diffSuppressFuncIPNet("ip", "0.0.0.0/0", "0.0.0.0/0", nil))
+	assert.False(t, diffSuppressFuncIPNet("ip", "192.168.1.0/24", "192.168.1.0/25", nil))
+	assert.False(t, diffSuppressFuncIPNet("ip", "192.168.1.0/24", "192.168.2.0/24", nil))
+	assert.False(t, diffSuppressFuncIPNet("ip", "", "0.0.0.0/0", nil))
+	assert.False(t, diffSuppressFuncIPNet("ip", "192.168.1.0/24", "not an ip", nil))
+}
+
 func TestIsHTTPCodeError(t *testing.T) {
 	assert.True(t, isHTTPCodeError(&scw.ResponseError{StatusCode: http.StatusBadRequest}, http.StatusBadRequest))
 	assert.False(t, isHTTPCodeError(nil, http.StatusBadRequest))
@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +13,9 @@ import (
 const (
 	defaultVPCGatewayTimeout = 10 * time.Minute
 	defaultVPCGatewayRetry   = 5 * time.Second
+
+	// minVPCGatewayDHCPTimerGap is the minimum gap, in seconds, between renew_timer, rebind_timer and valid_lifetime
+	minVPCGatewayDHCPTimerGap = 30
 )
 
 // vpcgwAPIWithZone returns a new VPC API and the zone for a Create request
@@ -69,3 +73,52 @@ func waitForVPCGatewayNetwork(ctx context.Context, api *vpcgw.API, zone scw.Zone
 
 	return gatewayNetwork, err
 }
+
+// validateVPCPublicGatewayDHCPTimers checks that renewTimer < rebindTimer < validLifetime, each being at least 30s lower than the next one.
+// A zero value is not set yet and is not checked.
+func validateVPCPublicGatewayDHCPTimers(renewTimer, rebindTimer, validLifetime int) error {
+	if renewTimer != 0 && rebindTimer != 0 && renewTimer > rebindTimer-minVPCGatewayDHCPTimerGap {
+		return fmt.Errorf("renew_timer (%ds) must be at least %ds lower than rebind_timer (%ds)", renewTimer, minVPCGatewayDHCPTimerGap, rebindTimer)
+	}
+
+	if rebindTimer != 0 && validLifetime != 0 && rebindTimer > validLifetime-minVPCGatewayDHCPTimerGap {
+		return fmt.Errorf("rebind_timer (%ds) must be at least %ds lower than valid_lifetime (%ds)", rebindTimer, minVPCGatewayDHCPTimerGap, validLifetime)
+	}
+
+	if renewTimer != 0 && validLifetime != 0 && renewTimer > validLifetime-minVPCGatewayDHCPTimerGap {
+		return fmt.Errorf("renew_timer (%ds) must be at least %ds lower than valid_lifetime (%ds)", renewTimer, minVPCGatewayDHCPTimerGap, validLifetime)
+	}
+
+	return nil
+}
+
+// expandVPCGatewayDHCPDNSServersOverride always returns a non-nil list so that removing every override clears it,
+// a nil list leaving the overrides unchanged.
+func expandVPCGatewayDHCPDNSServersOverride(raw interface{}) *[]string {
+	servers := []string{}
+	for _, server := range raw.([]interface{}) {
+		servers = append(servers, server.(string))
+	}
+	return &servers
+}
+
+// flattenVPCGatewayDHCPDNSServersOverride drops the gateway address the API echoes as an implicit DNS server
+// when push_dns_server is set, unless it is part of the configured overrides.
+func flattenVPCGatewayDHCPDNSServersOverride(servers []string, gatewayAddress string, pushDNSServer bool, configured []string) []string {
+	if !pushDNSServer {
+		return servers
+	}
+	for _, server := range configured {
+		if server == gatewayAddress {
+			return servers
+		}
+	}
+
+	flat := []string(nil)
+	for _, server := range servers {
+		if server != gatewayAddress {
+			flat = append(flat, server)
+		}
+	}
+	return flat
+}
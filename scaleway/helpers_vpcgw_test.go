@@ -0,0 +1,132 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateVPCPublicGatewayDHCPTimers(t *testing.T) {
+	tests := []struct {
+		name          string
+		renewTimer    int
+		rebindTimer   int
+		validLifetime int
+		wantErr       string
+	}{
+		{
+			name:          "defaults",
+			renewTimer:    3000,
+			rebindTimer:   3060,
+			validLifetime: 3600,
+		},
+		{
+			name:          "custom",
+			renewTimer:    2000,
+			rebindTimer:   2060,
+			validLifetime: 3000,
+		},
+		{
+			name:          "exact gap",
+			renewTimer:    2000,
+			rebindTimer:   2030,
+			validLifetime: 2060,
+		},
+		{
+			name:        "unknown lifetime",
+			renewTimer:  2000,
+			rebindTimer: 2060,
+		},
+		{
+			name:          "renew after rebind",
+			renewTimer:    3100,
+			rebindTimer:   3060,
+			validLifetime: 3600,
+			wantErr:       "renew_timer (3100s) must be at least 30s lower than rebind_timer (3060s)",
+		},
+		{
+			name:          "renew too close to rebind",
+			renewTimer:    3040,
+			rebindTimer:   3060,
+			validLifetime: 3600,
+			wantErr:       "renew_timer (3040s) must be at least 30s lower than rebind_timer (3060s)",
+		},
+		{
+			name:          "rebind after lifetime",
+			renewTimer:    2000,
+			rebindTimer:   4000,
+			validLifetime: 3600,
+			wantErr:       "rebind_timer (4000s) must be at least 30s lower than valid_lifetime (3600s)",
+		},
+		{
+			name:          "renew after lifetime with unknown rebind",
+			renewTimer:    4000,
+			validLifetime: 3600,
+			wantErr:       "renew_timer (4000s) must be at least 30s lower than valid_lifetime (3600s)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVPCPublicGatewayDHCPTimers(tt.renewTimer, tt.rebindTimer, tt.validLifetime)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlattenVPCGatewayDHCPDNSServersOverride(t *testing.T) {
+	tests := []struct {
+		name          string
+		servers       []string
+		pushDNSServer bool
+		configured    []string
+		want          []string
+	}{
+		{
+			name:          "no override",
+			servers:       nil,
+			pushDNSServer: true,
+			want:          nil,
+		},
+		{
+			name:          "gateway address echoed",
+			servers:       []string{"192.168.1.1"},
+			pushDNSServer: true,
+			want:          nil,
+		},
+		{
+			name:          "gateway address echoed with overrides",
+			servers:       []string{"192.168.1.1", "192.168.1.3", "192.168.1.2"},
+			pushDNSServer: true,
+			configured:    []string{"192.168.1.3", "192.168.1.2"},
+			want:          []string{"192.168.1.3", "192.168.1.2"},
+		},
+		{
+			name:          "gateway address configured",
+			servers:       []string{"192.168.1.2", "192.168.1.1"},
+			pushDNSServer: true,
+			configured:    []string{"192.168.1.2", "192.168.1.1"},
+			want:          []string{"192.168.1.2", "192.168.1.1"},
+		},
+		{
+			name:       "gateway address without push_dns_server",
+			servers:    []string{"192.168.1.1", "192.168.1.2"},
+			configured: []string{"192.168.1.2"},
+			want:       []string{"192.168.1.1", "192.168.1.2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, flattenVPCGatewayDHCPDNSServersOverride(tt.servers, "192.168.1.1", tt.pushDNSServer, tt.configured))
+		})
+	}
+}
+
+func TestExpandVPCGatewayDHCPDNSServersOverride(t *testing.T) {
+	assert.Equal(t, &[]string{}, expandVPCGatewayDHCPDNSServersOverride([]interface{}{}))
+	assert.Equal(t, &[]string{"192.168.1.3", "192.168.1.2"}, expandVPCGatewayDHCPDNSServersOverride([]interface{}{"192.168.1.3", "192.168.1.2"}))
+}
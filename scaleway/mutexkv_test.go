@@ -0,0 +1,40 @@
+package scaleway
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutexKV(t *testing.T) {
+	m := newMutexKV()
+
+	m.Lock("foo")
+	// Another key is not blocked
+	m.Lock("bar")
+	m.Unlock("bar")
+
+	locked := make(chan struct{})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		m.Lock("foo")
+		close(locked)
+		m.Unlock("foo")
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("the same key must not be locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	m.Unlock("foo")
+	wg.Wait()
+
+	_, ok := <-locked
+	assert.False(t, ok)
+}
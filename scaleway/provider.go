@@ -67,6 +67,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_container_namespace":                 resourceScalewayContainerNamespace(),
 				"scaleway_domain_record":                       resourceScalewayDomainRecord(),
 				"scaleway_domain_zone":                         resourceScalewayDomainZone(),
+				"scaleway_flexible_ip_mac_address":             resourceScalewayFlexibleIPMACAddress(),
 				"scaleway_function_namespace":                  resourceScalewayFunctionNamespace(),
 				"scaleway_instance_ip":                         resourceScalewayInstanceIP(),
 				"scaleway_instance_ip_reverse_dns":             resourceScalewayInstanceIPReverseDNS(),
@@ -75,6 +76,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_instance_security_group_rules":       resourceScalewayInstanceSecurityGroupRules(),
 				"scaleway_instance_server":                     resourceScalewayInstanceServer(),
 				"scaleway_instance_snapshot":                   resourceScalewayInstanceSnapshot(),
+				"scaleway_instance_image":                      resourceScalewayInstanceImage(),
 				"scaleway_instance_placement_group":            resourceScalewayInstancePlacementGroup(),
 				"scaleway_instance_private_nic":                resourceScalewayInstancePrivateNIC(),
 				"scaleway_iot_hub":                             resourceScalewayIotHub(),
@@ -93,9 +95,12 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_container":                           resourceScalewayContainer(),
 				"scaleway_rdb_acl":                             resourceScalewayRdbACL(),
 				"scaleway_rdb_database":                        resourceScalewayRdbDatabase(),
+				"scaleway_rdb_database_backup":                 resourceScalewayRdbDatabaseBackup(),
+				"scaleway_rdb_database_backup_restore":         resourceScalewayRdbDatabaseBackupRestore(),
 				"scaleway_rdb_instance":                        resourceScalewayRdbInstance(),
 				"scaleway_rdb_privilege":                       resourceScalewayRdbPrivilege(),
 				"scaleway_rdb_user":                            resourceScalewayRdbUser(),
+				"scaleway_rdb_users":                           resourceScalewayRdbUsers(),
 				"scaleway_redis_cluster":                       resourceScalewayRedisCluster(),
 				"scaleway_object_bucket":                       resourceScalewayObjectBucket(),
 				"scaleway_vpc_public_gateway":                  resourceScalewayVPCPublicGateway(),
@@ -109,6 +114,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 
 			DataSourcesMap: map[string]*schema.Resource{
 				"scaleway_account_ssh_key":             dataSourceScalewayAccountSSHKey(),
+				"scaleway_availability_zones":          dataSourceScalewayAvailabilityZones(),
 				"scaleway_baremetal_offer":             dataSourceScalewayBaremetalOffer(),
 				"scaleway_baremetal_os":                dataSourceScalewayBaremetalOs(),
 				"scaleway_baremetal_server":            dataSourceScalewayBaremetalServer(),
@@ -116,10 +122,13 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_domain_zone":                 dataSourceScalewayDomainZone(),
 				"scaleway_container_namespace":         dataSourceScalewayContainerNamespace(),
 				"scaleway_container":                   dataSourceScalewayContainer(),
+				"scaleway_container_logs":              dataSourceScalewayContainerLogs(),
 				"scaleway_function_namespace":          dataSourceScalewayFunctionNamespace(),
+				"scaleway_function_logs":               dataSourceScalewayFunctionLogs(),
 				"scaleway_instance_ip":                 dataSourceScalewayInstanceIP(),
 				"scaleway_instance_security_group":     dataSourceScalewayInstanceSecurityGroup(),
 				"scaleway_instance_server":             dataSourceScalewayInstanceServer(),
+				"scaleway_instance_server_types":       dataSourceScalewayInstanceServerTypes(),
 				"scaleway_instance_image":              dataSourceScalewayInstanceImage(),
 				"scaleway_instance_volume":             dataSourceScalewayInstanceVolume(),
 				"scaleway_iot_hub":                     dataSourceScalewayIotHub(),
@@ -127,8 +136,10 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_k8s_cluster":                 dataSourceScalewayK8SCluster(),
 				"scaleway_k8s_pool":                    dataSourceScalewayK8SPool(),
 				"scaleway_lb":                          dataSourceScalewayLb(),
+				"scaleway_lb_backend_server_health":    dataSourceScalewayLbBackendServerHealth(),
 				"scaleway_lb_certificate":              dataSourceScalewayLbCertificate(),
 				"scaleway_lb_ip":                       dataSourceScalewayLbIP(),
+				"scaleway_lb_stats":                    dataSourceScalewayLbStats(),
 				"scaleway_marketplace_image":           dataSourceScalewayMarketplaceImage(),
 				"scaleway_object_bucket":               dataSourceScalewayObjectBucket(),
 				"scaleway_rdb_acl":                     dataSourceScalewayRDBACL(),
@@ -255,11 +266,12 @@ func loadProfile(ctx context.Context, d *schema.ResourceData) (*scw.Profile, err
 	envProfile := scw.LoadEnvProfile()
 
 	providerProfile := &scw.Profile{}
+	var namedProfile *scw.Profile
 	if d != nil {
 		if profileName, exist := d.GetOk("profile"); exist {
-			profileFromConfig, err := config.GetProfile(profileName.(string))
-			if err == nil {
-				providerProfile = profileFromConfig
+			namedProfile, err = config.GetProfile(profileName.(string))
+			if err != nil {
+				return nil, fmt.Errorf("cannot load profile %s from the configuration file: %w", profileName, err)
 			}
 		}
 		if accessKey, exist := d.GetOk("access_key"); exist {
@@ -282,20 +294,88 @@ func loadProfile(ctx context.Context, d *schema.ResourceData) (*scw.Profile, err
 		}
 	}
 
-	profile := scw.MergeProfiles(defaultZoneProfile, activeProfile, providerProfile, envProfile)
-
-	// If profile have a defaultZone but no defaultRegion we set the defaultRegion
-	// to the one of the defaultZone
-	if profile.DefaultZone != nil && *profile.DefaultZone != "" &&
-		(profile.DefaultRegion == nil || *profile.DefaultRegion == "") {
-		zone := scw.Zone(*profile.DefaultZone)
-		tflog.Debug(ctx, fmt.Sprintf("guess region from %s zone", zone))
-		region, err := zone.Region()
-		if err == nil {
-			profile.DefaultRegion = scw.StringPtr(region.String())
-		} else {
-			tflog.Debug(ctx, fmt.Sprintf("cannot guess region: %s", err.Error()))
+	// Every field is taken from the first of these sources defining it, by order of precedence.
+	// A profile selected in the provider block replaces the active profile of the config file
+	// and takes precedence over the environment, so that each provider alias targets its own profile.
+	sources := []profileSource{
+		{name: "provider configuration", profile: providerProfile},
+		{name: "environment", profile: envProfile},
+		{name: "config file", profile: activeProfile},
+		{name: "provider default", profile: defaultZoneProfile},
+	}
+	if namedProfile != nil {
+		sources = []profileSource{
+			{name: "provider configuration", profile: providerProfile},
+			{name: "provider profile", profile: namedProfile},
+			{name: "environment", profile: envProfile},
+			{name: "provider default", profile: defaultZoneProfile},
 		}
 	}
+
+	profiles := make([]*scw.Profile, 0, len(sources))
+	for i := len(sources) - 1; i >= 0; i-- {
+		profiles = append(profiles, sources[i].profile)
+	}
+	profile := scw.MergeProfiles(profiles[0], profiles[1:]...)
+
+	// The default zone and region come as a pair from the first source defining one of them
+	zone, region, source := resolveProfileLocality(sources)
+	tflog.Info(ctx, fmt.Sprintf("using default zone %q and region %q from %s", zone, region, source))
+	if zone != "" {
+		profile.DefaultZone = scw.StringPtr(zone.String())
+	}
+	if region != "" {
+		profile.DefaultRegion = scw.StringPtr(region.String())
+	}
+
 	return profile, nil
 }
+
+// profileSource is a named source of configuration, such as the environment or the config file
+type profileSource struct {
+	name    string
+	profile *scw.Profile
+}
+
+// resolveProfileLocality returns the default zone and region from the first source, by order of precedence,
+// defining a zone or a region. A missing region is guessed from the zone. A missing zone is taken from the next
+// sources when it is in the region, the first zone of the region is used otherwise.
+func resolveProfileLocality(sources []profileSource) (scw.Zone, scw.Region, string) {
+	for i, source := range sources {
+		if source.profile == nil {
+			continue
+		}
+		zone := scw.Zone(flattenStringPtr(source.profile.DefaultZone).(string))
+		region := scw.Region(flattenStringPtr(source.profile.DefaultRegion).(string))
+		if zone == "" && region == "" {
+			continue
+		}
+
+		if region == "" {
+			region, _ = zone.Region()
+		}
+		if zone == "" {
+			zone = profileLocalityZoneInRegion(sources[i+1:], region)
+		}
+		return zone, region, source.name
+	}
+
+	return "", "", ""
+}
+
+func profileLocalityZoneInRegion(sources []profileSource, region scw.Region) scw.Zone {
+	for _, source := range sources {
+		if source.profile == nil {
+			continue
+		}
+		zone := scw.Zone(flattenStringPtr(source.profile.DefaultZone).(string))
+		if zoneRegion, err := zone.Region(); err == nil && zoneRegion == region {
+			return zone
+		}
+	}
+
+	if zones := region.GetZones(); len(zones) > 0 {
+		return zones[0]
+	}
+	return ""
+}
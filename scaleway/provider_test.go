@@ -14,6 +14,7 @@ import (
 	"github.com/dnaeon/go-vcr/cassette"
 	"github.com/dnaeon/go-vcr/recorder"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/scaleway-sdk-go/strcase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -115,3 +116,145 @@ func NewTestTools(t *testing.T) *TestTools {
 		ctx:     ctx,
 	}
 }
+
+func TestResolveProfileLocality(t *testing.T) {
+	profileWith := func(zone, region string) *scw.Profile {
+		profile := &scw.Profile{}
+		if zone != "" {
+			profile.DefaultZone = scw.StringPtr(zone)
+		}
+		if region != "" {
+			profile.DefaultRegion = scw.StringPtr(region)
+		}
+		return profile
+	}
+	sources := func(provider, env, config *scw.Profile) []profileSource {
+		return []profileSource{
+			{name: "provider", profile: provider},
+			{name: "env", profile: env},
+			{name: "config", profile: config},
+			{name: "default", profile: profileWith("fr-par-1", "fr-par")},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		sources        []profileSource
+		expectedZone   scw.Zone
+		expectedRegion scw.Region
+		expectedSource string
+	}{
+		{
+			name:           "default",
+			sources:        sources(&scw.Profile{}, &scw.Profile{}, &scw.Profile{}),
+			expectedZone:   scw.ZoneFrPar1,
+			expectedRegion: scw.RegionFrPar,
+			expectedSource: "default",
+		},
+		{
+			name:           "config file over default",
+			sources:        sources(&scw.Profile{}, &scw.Profile{}, profileWith("pl-waw-1", "pl-waw")),
+			expectedZone:   scw.ZonePlWaw1,
+			expectedRegion: scw.RegionPlWaw,
+			expectedSource: "config",
+		},
+		{
+			name:           "env over config file",
+			sources:        sources(&scw.Profile{}, profileWith("nl-ams-2", ""), profileWith("pl-waw-1", "pl-waw")),
+			expectedZone:   scw.ZoneNlAms2,
+			expectedRegion: scw.RegionNlAms,
+			expectedSource: "env",
+		},
+		{
+			name:           "provider over env",
+			sources:        sources(profileWith("fr-par-2", ""), profileWith("nl-ams-1", "nl-ams"), &scw.Profile{}),
+			expectedZone:   scw.ZoneFrPar2,
+			expectedRegion: scw.RegionFrPar,
+			expectedSource: "provider",
+		},
+		{
+			name:           "region only keeps a zone of the region from lower sources",
+			sources:        sources(profileWith("", "nl-ams"), &scw.Profile{}, profileWith("nl-ams-2", "")),
+			expectedZone:   scw.ZoneNlAms2,
+			expectedRegion: scw.RegionNlAms,
+			expectedSource: "provider",
+		},
+		{
+			name:           "region only ignores zones of other regions",
+			sources:        sources(profileWith("", "pl-waw"), profileWith("fr-par-2", ""), &scw.Profile{}),
+			expectedZone:   scw.ZonePlWaw1,
+			expectedRegion: scw.RegionPlWaw,
+			expectedSource: "provider",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone, region, source := resolveProfileLocality(tt.sources)
+			assert.Equal(t, tt.expectedZone, zone)
+			assert.Equal(t, tt.expectedRegion, region)
+			assert.Equal(t, tt.expectedSource, source)
+		})
+	}
+}
+
+func TestLoadProfile_NamedProfile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(configPath, []byte(`
+access_key: SCWDEFAULTXXXXXXXXXX
+default_zone: fr-par-1
+profiles:
+  other:
+    access_key: SCWOTHERXXXXXXXXXXXX
+    default_zone: nl-ams-2
+`), 0600)
+	require.NoError(t, err)
+
+	t.Setenv("SCW_CONFIG_PATH", configPath)
+	t.Setenv("SCW_ACCESS_KEY", "SCWENVXXXXXXXXXXXXXX")
+	t.Setenv("SCW_DEFAULT_ZONE", "pl-waw-1")
+	for _, key := range []string{"SCW_PROFILE", "SCW_DEFAULT_REGION", "SCW_API_URL"} {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+
+	providerSchema := Provider(DefaultProviderConfig())().Schema
+
+	// Without profile, the environment takes precedence over the config file
+	profile, err := loadProfile(context.Background(), schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{}))
+	require.NoError(t, err)
+	assert.Equal(t, "SCWENVXXXXXXXXXXXXXX", *profile.AccessKey)
+	assert.Equal(t, "pl-waw-1", *profile.DefaultZone)
+	assert.Equal(t, "pl-waw", *profile.DefaultRegion)
+
+	// The provider block arguments take precedence over the environment, credentials included
+	profile, err = loadProfile(context.Background(), schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"access_key": "SCWPROVIDERXXXXXXXXX",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "SCWPROVIDERXXXXXXXXX", *profile.AccessKey)
+	assert.Equal(t, "pl-waw-1", *profile.DefaultZone)
+
+	// A named profile takes precedence over the environment
+	profile, err = loadProfile(context.Background(), schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"profile": "other",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "SCWOTHERXXXXXXXXXXXX", *profile.AccessKey)
+	assert.Equal(t, "nl-ams-2", *profile.DefaultZone)
+	assert.Equal(t, "nl-ams", *profile.DefaultRegion)
+
+	// The provider block arguments take precedence over the named profile
+	profile, err = loadProfile(context.Background(), schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"profile": "other",
+		"zone":    "fr-par-2",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "SCWOTHERXXXXXXXXXXXX", *profile.AccessKey)
+	assert.Equal(t, "fr-par-2", *profile.DefaultZone)
+	assert.Equal(t, "fr-par", *profile.DefaultRegion)
+
+	_, err = loadProfile(context.Background(), schema.TestResourceDataRaw(t, providerSchema, map[string]interface{}{
+		"profile": "unknown",
+	}))
+	assert.ErrorContains(t, err, "cannot load profile unknown")
+}
@@ -29,15 +29,31 @@ func resourceScalewayAccountSSKKey() *schema.Resource {
 				Description: "The name of the SSH key",
 			},
 			"public_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The public SSH key",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The public SSH key",
+				ValidateFunc: validationSSHPublicKey(),
 				// We don't consider trailing \n as diff
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return strings.Trim(old, "\n") == strings.Trim(new, "\n")
 				},
 			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fingerprint of the SSH key",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the SSH key",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last update of the SSH key",
+			},
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
 		},
@@ -53,6 +69,9 @@ func resourceScalewayAccountSSHKeyCreate(ctx context.Context, d *schema.Resource
 		ProjectID: expandStringPtr(d.Get("project_id")),
 	}, scw.WithContext(ctx))
 	if err != nil {
+		if is409Error(err) {
+			return diag.Errorf("this SSH public key is already registered in your account, import it with `terraform import` or remove it from the console: %s", err)
+		}
 		return diag.FromErr(err)
 	}
 
@@ -77,6 +96,9 @@ func resourceScalewayAccountSSHKeyRead(ctx context.Context, d *schema.ResourceDa
 
 	_ = d.Set("name", res.Name)
 	_ = d.Set("public_key", res.PublicKey)
+	_ = d.Set("fingerprint", res.Fingerprint)
+	_ = d.Set("created_at", flattenTime(res.CreatedAt))
+	_ = d.Set("updated_at", flattenTime(res.UpdatedAt))
 	_ = d.Set("organization_id", res.OrganizationID)
 	_ = d.Set("project_id", res.ProjectID)
 
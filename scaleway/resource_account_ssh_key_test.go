@@ -63,6 +63,8 @@ func TestAccScalewayAccountSSHKey_basic(t *testing.T) {
 					testAccCheckScalewayAccountSSHKeyExists(tt, "scaleway_account_ssh_key.main"),
 					resource.TestCheckResourceAttr("scaleway_account_ssh_key.main", "name", name),
 					resource.TestCheckResourceAttr("scaleway_account_ssh_key.main", "public_key", SSHKey),
+					resource.TestCheckResourceAttrSet("scaleway_account_ssh_key.main", "fingerprint"),
+					resource.TestCheckResourceAttrSet("scaleway_account_ssh_key.main", "created_at"),
 				),
 			},
 			{
@@ -24,6 +24,7 @@ func resourceScalewayContainer() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultContainerTimeout),
 			Default: schema.DefaultTimeout(defaultContainerNamespaceTimeout),
 		},
 		SchemaVersion: 0,
@@ -123,11 +124,22 @@ func resourceScalewayContainer() *schema.Resource {
 					container.ContainerProtocolH2c.String(),
 					container.ContainerProtocolHTTP1.String()}, false),
 			},
-			"port": {
-				Type:        schema.TypeInt,
-				Computed:    true,
+			"http_option": {
+				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The port to expose the container. Defaults to 8080",
+				Computed:    true,
+				Description: "Configure how HTTP and HTTPS requests are handled, enabled serves both while redirected answers HTTP requests with a redirection to HTTPS. The API defaults to enabled.",
+				ValidateFunc: validation.StringInSlice([]string{
+					containerHTTPOptionEnabled,
+					containerHTTPOptionRedirected,
+				}, false),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Computed:     true,
+				Optional:     true,
+				Description:  "The port to expose the container. Defaults to 8080",
+				ValidateFunc: validation.IsPortNumber,
 			},
 			"deploy": {
 				Type:        schema.TypeBool,
@@ -188,13 +200,12 @@ func resourceScalewayContainerCreate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("creation container error: %s", err)
 	}
 
+	d.SetId(newRegionalIDString(region, res.ID))
+
 	// check if container should be deployed
 	shouldDeploy := d.Get("deploy")
 	if *expandBoolPtr(shouldDeploy) {
-		_, err := api.WaitForContainer(&container.WaitForContainerRequest{
-			ContainerID: res.ID,
-			Region:      region,
-		}, scw.WithContext(ctx))
+		_, err := waitForContainer(ctx, api, region, res.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.Errorf("unexpected waiting container error: %s", err)
 		}
@@ -210,7 +221,12 @@ func resourceScalewayContainerCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	d.SetId(newRegionalIDString(region, res.ID))
+	if *expandBoolPtr(shouldDeploy) {
+		_, err = waitForContainer(ctx, api, region, res.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return resourceScalewayContainerRead(ctx, d, meta)
 }
@@ -221,11 +237,9 @@ func resourceScalewayContainerRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	co, err := api.WaitForContainer(&container.WaitForContainerRequest{
-		ContainerID: containerID,
-		Region:      region,
-	}, scw.WithContext(ctx))
-	if err != nil {
+	// A container in error is still read so its status and error_message are stored.
+	co, err := waitForContainer(ctx, api, region, containerID, d.Timeout(schema.TimeoutRead))
+	if err != nil && co == nil {
 		return diag.Errorf("unexpected waiting container error: %s", err)
 	}
 
@@ -245,6 +259,7 @@ func resourceScalewayContainerRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("max_concurrency", int(co.MaxConcurrency))
 	_ = d.Set("domain_name", co.DomainName)
 	_ = d.Set("protocol", co.Protocol.String())
+	_ = d.Set("http_option", co.HTTPOption)
 	_ = d.Set("cron_status", co.Status.String())
 	_ = d.Set("port", int(co.Port))
 	_ = d.Set("deploy", scw.BoolPtr(*expandBoolPtr(d.Get("deploy"))))
@@ -270,11 +285,8 @@ func resourceScalewayContainerUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	// check for container state
-	_, err = api.WaitForContainer(&container.WaitForContainerRequest{
-		ContainerID: containerID,
-		Region:      region,
-	}, scw.WithContext(ctx))
-	if err != nil {
+	co, err := waitForContainer(ctx, api, region, containerID, d.Timeout(schema.TimeoutUpdate))
+	if err != nil && co == nil {
 		return diag.Errorf("unexpected waiting container error: %s", err)
 	}
 
@@ -363,6 +375,10 @@ func resourceScalewayContainerUpdate(ctx context.Context, d *schema.ResourceData
 		req.Port = toUint32(d.Get("port"))
 	}
 
+	if d.HasChanges("http_option") {
+		req.HTTPOption = expandStringPtr(d.Get("http_option"))
+	}
+
 	if d.HasChanges("deploy") {
 		req.Redeploy = expandBoolPtr(d.Get("deploy"))
 	}
@@ -372,6 +388,13 @@ func resourceScalewayContainerUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
+	if d.Get("deploy").(bool) {
+		_, err = waitForContainer(ctx, api, region, containerID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
 	return append(diags, resourceScalewayContainerRead(ctx, d, meta)...)
 }
 
@@ -381,11 +404,9 @@ func resourceScalewayContainerDelete(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	// check for container state
-	_, err = api.WaitForContainer(&container.WaitForContainerRequest{ContainerID: containerID,
-		Region: region,
-	}, scw.WithContext(ctx))
-	if err != nil {
+	// check for container state, a container in error can still be deleted
+	co, err := waitForContainer(ctx, api, region, containerID, d.Timeout(schema.TimeoutDelete))
+	if err != nil && co == nil {
 		return diag.Errorf("unexpected waiting container error: %s", err)
 	}
 
@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -56,9 +57,10 @@ func resourceScalewayContainerNamespace() *schema.Resource {
 				Computed:    true,
 				Description: "The ID of the registry namespace",
 			},
-			"region":          regionSchema(),
-			"organization_id": organizationIDSchema(),
-			"project_id":      projectIDSchema(),
+			"registry_visibility": registryVisibilitySchema(),
+			"region":              regionSchema(),
+			"organization_id":     organizationIDSchema(),
+			"project_id":          projectIDSchema(),
 		},
 	}
 }
@@ -82,11 +84,18 @@ func resourceScalewayContainerNamespaceCreate(ctx context.Context, d *schema.Res
 
 	d.SetId(newRegionalIDString(region, ns.ID))
 
-	_, err = waitForContainerNamespace(ctx, api, region, ns.ID, d.Timeout(schema.TimeoutCreate))
+	ns, err = waitForContainerNamespace(ctx, api, region, ns.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if visibility, ok := d.GetOk("registry_visibility"); ok {
+		err = setRegistryNamespaceVisibility(ctx, meta, region, ns.RegistryNamespaceID, visibility.(string), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayContainerNamespaceRead(ctx, d, meta)
 }
 
@@ -114,6 +123,14 @@ func resourceScalewayContainerNamespaceRead(ctx context.Context, d *schema.Resou
 	_ = d.Set("registry_endpoint", ns.RegistryEndpoint)
 	_ = d.Set("registry_namespace_id", ns.RegistryNamespaceID)
 
+	// The registry namespace is managed by the API, failing to read it should not fail the namespace read
+	visibility, err := getRegistryNamespaceVisibility(ctx, meta, region, ns.RegistryNamespaceID)
+	if err != nil {
+		l.Warningf("failed to read the visibility of registry namespace %s: %s", ns.RegistryNamespaceID, err)
+	} else {
+		_ = d.Set("registry_visibility", visibility)
+	}
+
 	return nil
 }
 
@@ -146,6 +163,13 @@ func resourceScalewayContainerNamespaceUpdate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("registry_visibility") {
+		err = setRegistryNamespaceVisibility(ctx, meta, region, ns.RegistryNamespaceID, d.Get("registry_visibility").(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayContainerNamespaceRead(ctx, d, meta)
 }
 
@@ -164,12 +188,21 @@ func resourceScalewayContainerNamespaceDelete(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	_, err = api.DeleteNamespace(&container.DeleteNamespaceRequest{
+	deleteReq := &container.DeleteNamespaceRequest{
 		Region:      region,
 		NamespaceID: id,
-	}, scw.WithContext(ctx))
+	}
+	_, err = api.DeleteNamespace(deleteReq, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		// The namespace may still hold containers, e.g. deployed outside of terraform: they are deleted before trying again
+		errCleanup := deleteContainerNamespaceResources(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
+		if errCleanup != nil {
+			return diag.FromErr(fmt.Errorf("%s: %s", err, errCleanup))
+		}
+		_, err = api.DeleteNamespace(deleteReq, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return diag.FromErr(err)
+		}
 	}
 
 	_, err = waitForContainerNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
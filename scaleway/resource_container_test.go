@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	container "github.com/scaleway/scaleway-sdk-go/api/container/v1beta1"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -32,6 +33,16 @@ func init() {
 	}
 	l.Infof("start container registry with image: %s", testDockerIMG)
 }
+func TestResourceScalewayContainerPortValidation(t *testing.T) {
+	validatePort := resourceScalewayContainer().Schema["port"].ValidateFunc
+
+	_, errs := validatePort(8080, "port")
+	assert.Empty(t, errs)
+
+	_, errs = validatePort(70000, "port")
+	assert.NotEmpty(t, errs)
+}
+
 func TestAccScalewayContainer_Basic(t *testing.T) {
 	tt := NewTestTools(t)
 	defer tt.Cleanup()
@@ -23,7 +23,7 @@ func resourceScalewayDomainRecord() *schema.Resource {
 			Default: schema.DefaultTimeout(defaultDomainRecordTimeout),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceScalewayDomainRecordImport,
 		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -295,6 +295,42 @@ func resourceScalewayDomainRecordCreate(ctx context.Context, d *schema.ResourceD
 	return resourceScalewayDomainRecordRead(ctx, d, meta)
 }
 
+// resourceScalewayDomainRecordImport accepts either {dns_zone}/{id} or {dns_zone}/{type}/{name}[/{data}],
+// the record ID being resolved from the records of the zone in the latter case.
+func resourceScalewayDomainRecordImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tab := strings.SplitN(d.Id(), "/", 4)
+	if len(tab) == 2 {
+		return []*schema.ResourceData{d}, nil
+	}
+	if len(tab) < 3 {
+		return nil, fmt.Errorf("cant parse record import id %s, expected {dns_zone}/{id} or {dns_zone}/{type}/{name}[/{data}]", d.Id())
+	}
+
+	dnsZone, recordType, name := tab[0], domain.RecordType(strings.ToUpper(tab[1])), tab[2]
+	data := ""
+	if len(tab) == 4 {
+		data = tab[3]
+	}
+
+	res, err := newDomainAPI(meta).ListDNSZoneRecords(&domain.ListDNSZoneRecordsRequest{
+		DNSZone: dnsZone,
+		Name:    name,
+		Type:    recordType,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := findDomainRecordToImport(res.Records, recordType, name, data)
+	if err != nil {
+		return nil, fmt.Errorf("zone %s: %w", dnsZone, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", dnsZone, record.ID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceScalewayDomainRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	domainAPI := newDomainAPI(meta)
 	var record *domain.Record
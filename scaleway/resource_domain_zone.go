@@ -97,7 +97,7 @@ func resourceScalewayDomainZoneCreate(ctx context.Context, d *schema.ResourceDat
 
 	for i := range zones.DNSZones {
 		if zones.DNSZones[i].Domain == domainName && zones.DNSZones[i].Subdomain == subdomainName {
-			d.SetId(fmt.Sprintf("%s.%s", subdomainName, domainName))
+			d.SetId(zoneName)
 
 			return resourceScalewayDomainZoneRead(ctx, d, meta)
 		}
@@ -113,11 +113,12 @@ func resourceScalewayDomainZoneCreate(ctx context.Context, d *schema.ResourceDat
 
 	if err != nil {
 		if is409Error(err) {
+			d.SetId(zoneName)
 			return resourceScalewayDomainZoneRead(ctx, d, meta)
 		}
 		return diag.FromErr(err)
 	}
-	d.SetId(fmt.Sprintf("%s.%s", dnsZone.Subdomain, dnsZone.Domain))
+	d.SetId(domainZoneName(dnsZone))
 
 	return resourceScalewayDomainZoneRead(ctx, d, meta)
 }
@@ -140,16 +141,18 @@ func resourceScalewayDomainZoneRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	if len(zones.DNSZones) == 0 {
-		return diag.FromErr(fmt.Errorf("no zone found with the name %s", d.Id()))
+	// The DNS zone filter also lists the subzones of the zone
+	for _, z := range zones.DNSZones {
+		if domainZoneName(z) == d.Id() {
+			zone = z
+			break
+		}
 	}
-
-	if len(zones.DNSZones) > 1 {
-		return diag.FromErr(fmt.Errorf("%d zone found with the same name %s", len(zones.DNSZones), d.Id()))
+	if zone == nil {
+		d.SetId("")
+		return nil
 	}
 
-	zone = zones.DNSZones[0]
-
 	_ = d.Set("subdomain", zone.Subdomain)
 	_ = d.Set("domain", zone.Domain)
 	_ = d.Set("ns", zone.Ns)
@@ -160,22 +163,24 @@ func resourceScalewayDomainZoneRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("updated_at", zone.UpdatedAt.String())
 	_ = d.Set("project_id", zone.ProjectID)
 
-	return nil
+	return domainZoneStatusDiagnostics(zone)
 }
 
 func resourceScalewayDomainZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	domainAPI := newDomainAPI(meta)
 
-	if d.HasChangesExcept("subdomain") {
-		_, err := domainAPI.UpdateDNSZone(&domain.UpdateDNSZoneRequest{
+	if d.HasChange("subdomain") {
+		newZoneName := fmt.Sprintf("%s.%s", strings.ToLower(d.Get("subdomain").(string)), strings.ToLower(d.Get("domain").(string)))
+		dnsZone, err := domainAPI.UpdateDNSZone(&domain.UpdateDNSZoneRequest{
 			ProjectID:  d.Get("project_id").(string),
 			DNSZone:    d.Id(),
-			NewDNSZone: scw.StringPtr(d.Get("subdomain").(string)),
+			NewDNSZone: scw.StringPtr(newZoneName),
 		}, scw.WithContext(ctx))
 
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		d.SetId(domainZoneName(dnsZone))
 	}
 	return resourceScalewayDomainZoneRead(ctx, d, meta)
 }
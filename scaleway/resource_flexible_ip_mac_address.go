@@ -0,0 +1,222 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	flexibleip "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayFlexibleIPMACAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayFlexibleIPMACAddressCreate,
+		ReadContext:   resourceScalewayFlexibleIPMACAddressRead,
+		UpdateContext: resourceScalewayFlexibleIPMACAddressUpdate,
+		DeleteContext: resourceScalewayFlexibleIPMACAddressDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultFlexibleIPTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"flexible_ip_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The ID of the flexible IP the virtual MAC address is attached to",
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of the virtual MAC address",
+				ValidateFunc: validation.StringInSlice([]string{
+					flexibleip.MACAddressTypeKvm.String(),
+					flexibleip.MACAddressTypeVmware.String(),
+					flexibleip.MACAddressTypeXen.String(),
+				}, false),
+			},
+			"zone": zoneSchema(),
+			// Computed elements
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The virtual MAC address",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the virtual MAC address",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the virtual MAC address",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last update of the virtual MAC address",
+			},
+		},
+	}
+}
+
+func resourceScalewayFlexibleIPMACAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fipAPI, zone, err := flexibleIPAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fipID := expandID(d.Get("flexible_ip_id"))
+
+	fip, err := waitForFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if fip.MacAddress != nil {
+		return diag.FromErr(fmt.Errorf("flexible IP %s already has the virtual MAC address %s attached", fipID, fip.MacAddress.MacAddress))
+	}
+
+	_, err = fipAPI.GenerateMACAddr(&flexibleip.GenerateMACAddrRequest{
+		Zone:    zone,
+		FipID:   fipID,
+		MacType: flexibleip.MACAddressType(d.Get("type").(string)),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newZonedIDString(zone, fipID))
+
+	fip, err = waitForFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if fip.MacAddress == nil || fip.MacAddress.Status == flexibleip.MACAddressStatusError {
+		return diag.FromErr(fmt.Errorf("virtual MAC address generation failed on flexible IP %s", fipID))
+	}
+
+	return resourceScalewayFlexibleIPMACAddressRead(ctx, d, meta)
+}
+
+func resourceScalewayFlexibleIPMACAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fipAPI, zone, fipID, err := flexibleIPAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fip, err := fipAPI.GetFlexibleIP(&flexibleip.GetFlexibleIPRequest{
+		Zone:  zone,
+		FipID: fipID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if fip.MacAddress == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("flexible_ip_id", newZonedIDString(zone, fip.ID))
+	_ = d.Set("type", fip.MacAddress.MacType.String())
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("address", fip.MacAddress.MacAddress)
+	_ = d.Set("status", fip.MacAddress.Status.String())
+	_ = d.Set("created_at", flattenTime(fip.MacAddress.CreatedAt))
+	_ = d.Set("updated_at", flattenTime(fip.MacAddress.UpdatedAt))
+
+	return nil
+}
+
+func resourceScalewayFlexibleIPMACAddressUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fipAPI, zone, fipID, err := flexibleIPAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("flexible_ip_id") {
+		dstFipID := expandID(d.Get("flexible_ip_id"))
+
+		dstFip, err := waitForFlexibleIP(ctx, fipAPI, zone, dstFipID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if dstFip.MacAddress != nil {
+			return diag.FromErr(fmt.Errorf("flexible IP %s already has the virtual MAC address %s attached", dstFipID, dstFip.MacAddress.MacAddress))
+		}
+
+		_, err = waitForFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = fipAPI.MoveMACAddr(&flexibleip.MoveMACAddrRequest{
+			Zone:     zone,
+			FipID:    fipID,
+			DstFipID: dstFipID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(newZonedIDString(zone, dstFipID))
+
+		_, err = waitForFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil && !is404Error(err) {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForFlexibleIP(ctx, fipAPI, zone, dstFipID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayFlexibleIPMACAddressRead(ctx, d, meta)
+}
+
+func resourceScalewayFlexibleIPMACAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fipAPI, zone, fipID, err := flexibleIPAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = fipAPI.DeleteMACAddr(&flexibleip.DeleteMACAddrRequest{
+		Zone:  zone,
+		FipID: fipID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutDelete))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
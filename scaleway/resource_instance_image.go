@@ -0,0 +1,228 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayInstanceImage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceImageCreate,
+		ReadContext:   resourceScalewayInstanceImageRead,
+		DeleteContext: resourceScalewayInstanceImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultInstanceImageTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the image",
+			},
+			"root_volume_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "UUID of the snapshot used as the root volume of the image",
+			},
+			"additional_volume_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     validationUUIDorUUIDWithLocality(),
+					DiffSuppressFunc: diffSuppressFuncLocality,
+				},
+				Description: "UUIDs of the snapshots used as the additional volumes of the image",
+			},
+			"architecture": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  instance.ArchX86_64.String(),
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.ArchX86_64.String(),
+					instance.ArchArm.String(),
+				}, false),
+				Description: "Architecture of the image",
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "If true, the image will be public",
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The tags associated with the image",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the image",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the image",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last update of the image",
+			},
+			"zone":            zoneSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+func resourceScalewayInstanceImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotIDs := append([]string{d.Get("root_volume_id").(string)}, expandStrings(d.Get("additional_volume_ids"))...)
+	snapshots := make([]*instance.Snapshot, 0, len(snapshotIDs))
+	for _, snapshotID := range snapshotIDs {
+		snapshotZone, id, err := parseZonedID(snapshotID)
+		if err != nil {
+			snapshotZone, id = zone, snapshotID
+		}
+		snapshot, err := waitForInstanceSnapshot(ctx, instanceAPI, snapshotZone, id, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := validateInstanceImageSnapshots(zone, snapshots); err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &instance.CreateImageRequest{
+		Zone:       zone,
+		Name:       expandOrGenerateString(d.Get("name"), "image"),
+		RootVolume: snapshots[0].ID,
+		Arch:       instance.Arch(d.Get("architecture").(string)),
+		Project:    expandStringPtr(d.Get("project_id")),
+		Public:     d.Get("public").(bool),
+	}
+	if len(snapshots) > 1 {
+		req.ExtraVolumes = make(map[string]*instance.VolumeTemplate, len(snapshots)-1)
+		for i, snapshot := range snapshots[1:] {
+			req.ExtraVolumes[strconv.Itoa(i+1)] = &instance.VolumeTemplate{ID: snapshot.ID}
+		}
+	}
+	tags := expandStrings(d.Get("tags"))
+	if len(tags) > 0 {
+		req.Tags = tags
+	}
+
+	res, err := instanceAPI.CreateImage(req, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newZonedIDString(zone, res.Image.ID))
+
+	image, err := waitForInstanceImage(ctx, instanceAPI, zone, res.Image.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if image.State != instance.ImageStateAvailable {
+		return diag.Errorf("image %s is in state %s, wants %s", d.Id(), image.State, instance.ImageStateAvailable)
+	}
+
+	return resourceScalewayInstanceImageRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.GetImage(&instance.GetImageRequest{
+		ImageID: id,
+		Zone:    zone,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	image := res.Image
+
+	_ = d.Set("name", image.Name)
+	_ = d.Set("architecture", image.Arch.String())
+	_ = d.Set("public", image.Public)
+	_ = d.Set("tags", image.Tags)
+	_ = d.Set("state", image.State.String())
+	_ = d.Set("zone", image.Zone.String())
+	_ = d.Set("organization_id", image.Organization)
+	_ = d.Set("project_id", image.Project)
+	if image.RootVolume != nil {
+		_ = d.Set("root_volume_id", newZonedIDString(image.Zone, image.RootVolume.ID))
+	}
+	_ = d.Set("additional_volume_ids", flattenInstanceImageExtraVolumes(image.Zone, image.ExtraVolumes))
+	if image.CreationDate != nil {
+		_ = d.Set("created_at", image.CreationDate.Format(time.RFC3339))
+	}
+	if image.ModificationDate != nil {
+		_ = d.Set("updated_at", image.ModificationDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceScalewayInstanceImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForInstanceImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = instanceAPI.DeleteImage(&instance.DeleteImageRequest{
+		ImageID: id,
+		Zone:    zone,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(fmt.Errorf("couldn't delete image: %w", err))
+	}
+
+	return nil
+}
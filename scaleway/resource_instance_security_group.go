@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,6 +19,7 @@ func resourceScalewayInstanceSecurityGroup() *schema.Resource {
 		ReadContext:   resourceScalewayInstanceSecurityGroupRead,
 		UpdateContext: resourceScalewayInstanceSecurityGroupUpdate,
 		DeleteContext: resourceScalewayInstanceSecurityGroupDelete,
+		CustomizeDiff: customizeDiffInstanceSecurityGroupLockout,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -289,14 +291,57 @@ func resourceScalewayInstanceSecurityGroupUpdate(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
+	var warnings diag.Diagnostics
 	if !d.Get("external_rules").(bool) {
 		err = updateSecurityGroupeRules(ctx, d, zone, ID, instanceAPI)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		warnings = append(warnings, securityGroupLockoutWarning(instance.SecurityGroupRuleDirectionInbound, d.Get("inbound_default_policy").(string), d.Get("inbound_rule").([]interface{}))...)
+		warnings = append(warnings, securityGroupLockoutWarning(instance.SecurityGroupRuleDirectionOutbound, d.Get("outbound_default_policy").(string), d.Get("outbound_rule").([]interface{}))...)
+	}
+
+	return append(warnings, resourceScalewayInstanceSecurityGroupRead(ctx, d, meta)...)
+}
+
+// customizeDiffInstanceSecurityGroupLockout reports at plan time the directions dropping every connection.
+// A plan cannot carry warnings, so they are logged here and returned again once the rules are applied.
+func customizeDiffInstanceSecurityGroupLockout(ctx context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("external_rules").(bool) || !diff.HasChanges("inbound_default_policy", "outbound_default_policy", "inbound_rule", "outbound_rule") {
+		return nil
+	}
+	if !diff.NewValueKnown("inbound_rule") || !diff.NewValueKnown("outbound_rule") {
+		return nil
+	}
+
+	warnings := securityGroupLockoutWarning(instance.SecurityGroupRuleDirectionInbound, diff.Get("inbound_default_policy").(string), diff.Get("inbound_rule").([]interface{}))
+	warnings = append(warnings, securityGroupLockoutWarning(instance.SecurityGroupRuleDirectionOutbound, diff.Get("outbound_default_policy").(string), diff.Get("outbound_rule").([]interface{}))...)
+	for _, warning := range warnings {
+		tflog.Warn(ctx, fmt.Sprintf("%s: %s", warning.Summary, warning.Detail))
+	}
+
+	return nil
+}
+
+// securityGroupLockoutWarning returns a warning when a direction drops traffic by default
+// without any rule accepting traffic, as it would cut every connection in that direction.
+func securityGroupLockoutWarning(direction instance.SecurityGroupRuleDirection, defaultPolicy string, rules []interface{}) diag.Diagnostics {
+	if defaultPolicy != instance.SecurityGroupPolicyDrop.String() {
+		return nil
+	}
+
+	for _, rule := range rules {
+		if rule.(map[string]interface{})["action"] == instance.SecurityGroupRuleActionAccept.String() {
+			return nil
+		}
 	}
 
-	return resourceScalewayInstanceSecurityGroupRead(ctx, d, meta)
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s traffic is dropped by default and no %s rule accepts traffic", direction, direction),
+		Detail:   fmt.Sprintf("all %s connections will be blocked, you may lock yourself out of the instances using this security group", direction),
+	}}
 }
 
 // updateSecurityGroupeRules handles updating SecurityGroupRules
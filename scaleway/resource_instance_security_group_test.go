@@ -649,3 +649,13 @@ func TestAccScalewayInstanceSecurityGroup_EnableDefaultSecurity(t *testing.T) {
 		},
 	})
 }
+
+func TestSecurityGroupLockoutWarning(t *testing.T) {
+	acceptRule := map[string]interface{}{"action": "accept"}
+	dropRule := map[string]interface{}{"action": "drop"}
+
+	assert.Empty(t, securityGroupLockoutWarning(instance.SecurityGroupRuleDirectionInbound, "accept", nil))
+	assert.Empty(t, securityGroupLockoutWarning(instance.SecurityGroupRuleDirectionInbound, "drop", []interface{}{dropRule, acceptRule}))
+	assert.Len(t, securityGroupLockoutWarning(instance.SecurityGroupRuleDirectionInbound, "drop", nil), 1)
+	assert.Len(t, securityGroupLockoutWarning(instance.SecurityGroupRuleDirectionOutbound, "drop", []interface{}{dropRule}), 1)
+}
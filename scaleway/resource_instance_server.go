@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v1"
+	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	scwvalidation "github.com/scaleway/scaleway-sdk-go/validation"
 )
@@ -32,6 +33,7 @@ func resourceScalewayInstanceServer() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultInstanceServerWaitTimeout),
 		},
+		CustomizeDiff: customizeDiffInstanceServer,
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -42,18 +44,29 @@ func resourceScalewayInstanceServer() *schema.Resource {
 			},
 			"image": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
+				ExactlyOneOf:     []string{"image", "root_volume.0.snapshot_id"},
 				Description:      "The UUID or the label of the base image used by the server",
 				DiffSuppressFunc: diffSuppressFuncLocality,
 			},
 			"type": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ForceNew:         true,
 				Description:      "The instance type of the server", // TODO: link to scaleway pricing in the doc
 				DiffSuppressFunc: diffSuppressFuncIgnoreCase,
 			},
+			"gpu_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of GPUs of the instance type of the server",
+			},
+			"allow_stopping_for_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow the provider to stop and restart the server to change its type",
+			},
 			"tags": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -75,6 +88,50 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				DiffSuppressFunc: diffSuppressFuncLocality,
 				Description:      "The placement group the server is attached to",
 			},
+			"placement_validation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Rules checked at plan time on the placement of the server, based on its tags and type",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "A placement rule, every matching rule must be respected",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The rule applies to servers with this tag",
+									},
+									"type_prefix": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The rule applies to servers whose commercial type starts with this prefix, e.g. GPU",
+									},
+									"require_placement_group": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "The server must be in a placement group",
+									},
+									"zones": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The zones the server is allowed in",
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: validateStringInSliceWithWarning(allZones(), "zone"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"placement_group_policy_respected": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -123,6 +180,14 @@ func resourceScalewayInstanceServer() *schema.Resource {
 							Computed:    true,
 							Description: "Volume ID of the root volume",
 						},
+						"snapshot_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validationUUIDorUUIDWithLocality(),
+							DiffSuppressFunc: diffSuppressFuncLocality,
+							Description:      "Snapshot from which the root volume is created, instead of the image",
+						},
 					},
 				},
 			},
@@ -179,6 +244,12 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				Default:     false,
 				Description: "Enable dynamic IP on the server",
 			},
+			"protected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable the server protection preventing its deletion",
+			},
 			"state": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -222,6 +293,64 @@ func resourceScalewayInstanceServer() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"user_data_template": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A template file rendered at plan time into the cloud-init user data",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The path of the template file, using the Go text/template syntax",
+						},
+						"vars": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "The variables of the template",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"user_data_template_rendered": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cloud-init user data rendered from user_data_template",
+			},
+			"user_data_merge": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  instanceServerUserDataMergeReplace,
+				ValidateFunc: validation.StringInSlice([]string{
+					instanceServerUserDataMergeReplace,
+					instanceServerUserDataMergeMerge,
+				}, false),
+				Description: "How the cloud-init key of user_data and user_data_template are combined, replace forbids setting both",
+			},
+			"reboot_on_user_data_change": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "Reboot the server when its user data change so that cloud-init runs the new config",
+				ConflictsWith: []string{"replace_on_user_data_change"},
+			},
+			"replace_on_user_data_change": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "Recreate the server when its user data change",
+				ConflictsWith: []string{"reboot_on_user_data_change"},
+			},
+			"skip_cloud_init_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip the syntax validation of the cloud-init user data at plan time",
+			},
 			"private_network": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -233,10 +362,11 @@ func resourceScalewayInstanceServer() *schema.Resource {
 					},
 					Schema: map[string]*schema.Schema{
 						"pn_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validationUUIDorUUIDWithLocality(),
-							Description:  "The Private Network ID",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validationUUIDorUUIDWithLocality(),
+							DiffSuppressFunc: diffSuppressFuncLocality,
+							Description:      "The Private Network ID",
 						},
 						// Computed
 						"mac_address": {
@@ -253,6 +383,14 @@ func resourceScalewayInstanceServer() *schema.Resource {
 					},
 				},
 			},
+			"private_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The private IP of each private network of the server, in the order of private_network, read from the public gateway DHCP entries and empty without one",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"zone":            zoneSchema(),
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
@@ -260,6 +398,208 @@ func resourceScalewayInstanceServer() *schema.Resource {
 	}
 }
 
+func customizeDiffInstanceServer(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffInstanceServerType(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if err := customizeDiffInstanceServerTypeChange(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if err := customizeDiffInstanceServerUserDataTemplate(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if err := customizeDiffInstanceServerCloudInit(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if err := customizeDiffInstanceServerUserData(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	return customizeDiffInstanceServerPlacement(ctx, diff, meta)
+}
+
+// customizeDiffInstanceServerType checks that the instance type of the server is offered in its zone,
+// GPU types for instance are only available in a few zones.
+func customizeDiffInstanceServerType(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("type") || !diff.NewValueKnown("type") || !diff.NewValueKnown("zone") {
+		return nil
+	}
+
+	zone := scw.Zone(diff.Get("zone").(string))
+	if zone == "" {
+		zone, _ = meta.(*Meta).scwClient.GetDefaultZone()
+	}
+
+	instanceAPI := instance.NewAPI(meta.(*Meta).scwClient)
+	diags := checkInstanceServerTypeAvailability(ctx, instanceAPI, zone, diff.Get("type").(string))
+	if diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+	// A plan cannot carry warnings, they are reported again when the server is created
+	for _, warning := range diags {
+		tflog.Warn(ctx, fmt.Sprintf("%s: %s", warning.Summary, warning.Detail))
+	}
+
+	return nil
+}
+
+// customizeDiffInstanceServerTypeChange checks that the type of an existing server can be changed in place,
+// so that an incompatible type is reported before any other change is applied.
+func customizeDiffInstanceServerTypeChange(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("type") || !diff.NewValueKnown("type") {
+		return nil
+	}
+
+	oldState, newState := diff.GetChange("state")
+	if oldState.(string) != InstanceServerStateStopped && newState.(string) != InstanceServerStateStopped && !diff.Get("allow_stopping_for_update").(bool) {
+		return fmt.Errorf("instance must be stopped to change its type: set state to %q or allow_stopping_for_update to true", InstanceServerStateStopped)
+	}
+
+	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, diff.Id())
+	if err != nil {
+		return err
+	}
+	res, err := instanceAPI.GetServer(&instance.GetServerRequest{
+		Zone:     zone,
+		ServerID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return err
+	}
+
+	return validateServerTypeChange(ctx, instanceAPI, zone, res.Server, diff.Get("type").(string))
+}
+
+// customizeDiffInstanceServerPlacement checks the placement of the server against the opt-in placement_validation rules.
+// The rules are checked again when the server is created or updated, in case a value was not known at plan time.
+func customizeDiffInstanceServerPlacement(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") || !diff.NewValueKnown("type") || !diff.NewValueKnown("zone") || !diff.NewValueKnown("placement_group_id") {
+		return nil
+	}
+
+	zone := scw.Zone(diff.Get("zone").(string))
+	if zone == "" {
+		zone, _ = meta.(*Meta).scwClient.GetDefaultZone()
+	}
+
+	return validateInstanceServerPlacementConfig(diff.Get, zone)
+}
+
+// validateInstanceServerPlacementConfig checks the placement of the server against its placement_validation rules,
+// get being the Get method of either the plan or the state of the server.
+func validateInstanceServerPlacementConfig(get func(string) interface{}, zone scw.Zone) error {
+	rawValidation := get("placement_validation").([]interface{})
+	if len(rawValidation) == 0 || rawValidation[0] == nil {
+		return nil
+	}
+
+	return validateInstanceServerPlacement(
+		expandInstanceServerPlacementRules(rawValidation[0].(map[string]interface{})["rule"].([]interface{})),
+		expandStrings(get("tags")),
+		get("type").(string),
+		zone,
+		get("placement_group_id").(string) != "",
+	)
+}
+
+// customizeDiffInstanceServerUserDataTemplate renders user_data_template at plan time,
+// so that a change of the template file or of its variables shows up in the plan.
+func customizeDiffInstanceServerUserDataTemplate(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	rawTemplate := diff.Get("user_data_template").([]interface{})
+	if len(rawTemplate) == 0 || rawTemplate[0] == nil {
+		if diff.Get("user_data_template_rendered").(string) != "" {
+			return diff.SetNew("user_data_template_rendered", "")
+		}
+		return nil
+	}
+
+	if !diff.NewValueKnown("user_data_template.0.path") || !diff.NewValueKnown("user_data_template.0.vars") {
+		return diff.SetNewComputed("user_data_template_rendered")
+	}
+
+	userDataCloudInit, hasUserDataCloudInit := diff.Get("user_data").(map[string]interface{})["cloud-init"]
+	if hasUserDataCloudInit && diff.Get("user_data_merge").(string) != instanceServerUserDataMergeMerge {
+		return fmt.Errorf("user_data_template: cannot be used along with the cloud-init key of user_data unless user_data_merge is %s", instanceServerUserDataMergeMerge)
+	}
+	if hasUserDataCloudInit && !diff.NewValueKnown("user_data") {
+		return diff.SetNewComputed("user_data_template_rendered")
+	}
+
+	template := rawTemplate[0].(map[string]interface{})
+	rendered, err := renderUserDataTemplate(template["path"].(string), template["vars"].(map[string]interface{}))
+	if err != nil {
+		return fmt.Errorf("user_data_template: %s", err)
+	}
+
+	// The cloud-init key of user_data comes first so that the template can extend it
+	if hasUserDataCloudInit {
+		rendered, err = mergeCloudInit(userDataCloudInit.(string), rendered)
+		if err != nil {
+			return fmt.Errorf("user_data_merge: %s", err)
+		}
+	}
+
+	if !diff.Get("skip_cloud_init_validation").(bool) {
+		if err := validateCloudInit(rendered); err != nil {
+			return fmt.Errorf("user_data_template: %s", err)
+		}
+	}
+
+	if rendered != diff.Get("user_data_template_rendered").(string) {
+		return diff.SetNew("user_data_template_rendered", rendered)
+	}
+
+	return nil
+}
+
+// customizeDiffInstanceServerUserData plans a replacement of the server on a user data change when asked to.
+func customizeDiffInstanceServerUserData(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.Get("replace_on_user_data_change").(bool) {
+		return nil
+	}
+
+	if diff.HasChange("user_data") {
+		return diff.ForceNew("user_data")
+	}
+
+	if diff.HasChange("user_data_template_rendered") {
+		return diff.ForceNew("user_data_template_rendered")
+	}
+
+	return nil
+}
+
+// customizeDiffInstanceServerCloudInit rejects syntactically invalid cloud-init at plan time
+// so a bad config does not cost a full provisioning cycle.
+func customizeDiffInstanceServerCloudInit(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("skip_cloud_init_validation").(bool) {
+		return nil
+	}
+
+	if diff.HasChange("cloud_init") && diff.NewValueKnown("cloud_init") {
+		if err := validateCloudInit(diff.Get("cloud_init").(string)); err != nil {
+			return fmt.Errorf("cloud_init: %s", err)
+		}
+	}
+
+	if diff.HasChange("user_data") && diff.NewValueKnown("user_data") {
+		if cloudInit, ok := diff.Get("user_data").(map[string]interface{})["cloud-init"]; ok {
+			if err := validateCloudInit(cloudInit.(string)); err != nil {
+				return fmt.Errorf("user_data.cloud-init: %s", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 //gocyclo:ignore
 func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
@@ -271,10 +611,15 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 	// Create the server
 	////
 
+	// The placement group may not have been known at plan time
+	if err := validateInstanceServerPlacementConfig(d.Get, zone); err != nil {
+		return diag.FromErr(err)
+	}
+
 	commercialType := d.Get("type").(string)
 
 	imageUUID := expandZonedID(d.Get("image")).ID
-	if !scwvalidation.IsUUID(imageUUID) {
+	if imageUUID != "" && !scwvalidation.IsUUID(imageUUID) {
 		marketPlaceAPI := marketplace.NewAPI(meta.(*Meta).scwClient)
 		imageUUID, err = marketPlaceAPI.GetLocalImageIDByLabel(&marketplace.GetLocalImageIDByLabelRequest{
 			CommercialType: commercialType,
@@ -319,6 +664,11 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 		req.PlacementGroup = expandStringPtr(expandZonedID(placementGroupID).ID)
 	}
 
+	warnings := checkInstanceServerTypeAvailability(ctx, instanceAPI, req.Zone, req.CommercialType)
+	if warnings.HasError() {
+		return warnings
+	}
+
 	serverType := getServerType(ctx, instanceAPI, req.Zone, req.CommercialType)
 	if serverType == nil {
 		return diag.FromErr(fmt.Errorf("could not find a server type associated with %s", req.CommercialType))
@@ -356,6 +706,27 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 		Boot:       *isBoot,
 	}
 
+	// The root volume is created from the snapshot beforehand, as a server can only be created from an image
+	if snapshotID, ok := d.GetOk("root_volume.0.snapshot_id"); ok {
+		rootVolume, err := createInstanceVolumeFromSnapshot(ctx, instanceAPI, zone, &instance.CreateVolumeRequest{
+			Zone:         zone,
+			Name:         req.Name,
+			Project:      req.Project,
+			VolumeType:   instance.VolumeVolumeType(d.Get("root_volume.0.volume_type").(string)),
+			BaseSnapshot: scw.StringPtr(expandZonedID(snapshotID).ID),
+		}, scw.Size(uint64(sizeInput)*gb), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		req.Volumes["0"] = &instance.VolumeServerTemplate{
+			ID:         rootVolume.ID,
+			Name:       rootVolume.Name,
+			VolumeType: rootVolume.VolumeType,
+			Size:       rootVolume.Size,
+		}
+	}
+
 	if raw, ok := d.GetOk("additional_volume_ids"); ok {
 		for i, volumeID := range raw.([]interface{}) {
 			// We have to get the volume to know whether it is a local or a block volume
@@ -377,6 +748,7 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 
 	// Validate total local volume sizes.
 	if err = validateLocalVolumeSizes(req.Volumes, serverType, req.CommercialType); err != nil {
+		deleteInstanceVolumeFromSnapshot(ctx, d, instanceAPI, zone, req.Volumes["0"])
 		return diag.FromErr(err)
 	}
 
@@ -385,6 +757,7 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 
 	res, err := instanceAPI.CreateServer(req, scw.WithContext(ctx))
 	if err != nil {
+		deleteInstanceVolumeFromSnapshot(ctx, d, instanceAPI, zone, req.Volumes["0"])
 		return diag.FromErr(err)
 	}
 
@@ -395,6 +768,18 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	// The protection cannot be set at creation
+	if d.Get("protected").(bool) {
+		_, err = instanceAPI.UpdateServer(&instance.UpdateServerRequest{
+			Zone:      zone,
+			ServerID:  res.Server.ID,
+			Protected: scw.BoolPtr(true),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	////
 	// Set user data
 	////
@@ -415,6 +800,10 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 		userDataRequests.UserData["cloud-init"] = bytes.NewBufferString(cloudInit.(string))
 	}
 
+	if rendered, ok := d.GetOk("user_data_template_rendered"); ok {
+		userDataRequests.UserData["cloud-init"] = bytes.NewBufferString(rendered.(string))
+	}
+
 	if len(userDataRequests.UserData) > 0 {
 		_, err := waitForInstanceServer(ctx, instanceAPI, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -468,7 +857,9 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
-	return resourceScalewayInstanceServerRead(ctx, d, meta)
+	_ = d.Set("gpu_count", instanceServerTypeGPUCount(serverType))
+
+	return append(warnings, resourceScalewayInstanceServerRead(ctx, d, meta)...)
 }
 
 //gocyclo:ignore
@@ -502,18 +893,37 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 		_ = d.Set("boot_type", server.BootType)
 		_ = d.Set("bootscript_id", server.Bootscript.ID)
 		_ = d.Set("type", server.CommercialType)
+		// The server type is looked up on every page of the types, the count set at creation is kept when it cannot be found
+		serverType, err := instanceAPI.GetServerType(&instance.GetServerTypeRequest{
+			Zone: zone,
+			Name: server.CommercialType,
+		})
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("cannot get the GPU count of server type %s: %s", server.CommercialType, err))
+		} else {
+			_ = d.Set("gpu_count", instanceServerTypeGPUCount(serverType))
+		}
 		if len(server.Tags) > 0 {
 			_ = d.Set("tags", server.Tags)
 		}
-		_ = d.Set("security_group_id", newZonedID(zone, server.SecurityGroup.ID).String())
+		// A server created without security group is attached to the default security group of its project
+		if server.SecurityGroup != nil {
+			_ = d.Set("security_group_id", newZonedID(zone, server.SecurityGroup.ID).String())
+		} else {
+			_ = d.Set("security_group_id", nil)
+		}
 		_ = d.Set("enable_ipv6", server.EnableIPv6)
 		_ = d.Set("enable_dynamic_ip", server.DynamicIPRequired)
+		_ = d.Set("protected", server.Protected)
 		_ = d.Set("organization_id", server.Organization)
 		_ = d.Set("project_id", server.Project)
+		// allow_stopping_for_update is not stored by the API, set its value so that it is kept on import.
+		_ = d.Set("allow_stopping_for_update", d.Get("allow_stopping_for_update"))
 
 		// Image could be empty in an import context.
 		image := expandRegionalID(d.Get("image").(string))
-		if server.Image != nil && (image.ID == "" || scwvalidation.IsUUID(image.ID)) {
+		_, bootFromSnapshot := d.GetOk("root_volume.0.snapshot_id")
+		if server.Image != nil && !bootFromSnapshot && (image.ID == "" || scwvalidation.IsUUID(image.ID)) {
 			// TODO: If image is a label, check that server.Image.ID match the label.
 			// It could be useful if the user edit the image with another tool.
 			_ = d.Set("image", newZonedID(zone, server.Image.ID).String())
@@ -564,9 +974,10 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 			if i == 0 {
 				rootVolume := map[string]interface{}{}
 
-				vs, ok := d.Get("root_volume").([]map[string]interface{})
-				if ok && len(vs) > 0 {
-					rootVolume = vs[0]
+				// Keep the arguments the API does not return, such as boot
+				vs, ok := d.Get("root_volume").([]interface{})
+				if ok && len(vs) > 0 && vs[0] != nil {
+					rootVolume = vs[0].(map[string]interface{})
 				}
 
 				rootVolume["volume_id"] = newZonedID(zone, volume.ID).String()
@@ -574,6 +985,8 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 				_, rootVolumeAttributeSet := d.GetOk("root_volume") // Related to https://github.com/hashicorp/terraform-plugin-sdk/issues/142
 				rootVolume["delete_on_termination"] = d.Get("root_volume.0.delete_on_termination").(bool) || !rootVolumeAttributeSet
 				rootVolume["volume_type"] = volume.VolumeType
+				// The snapshot the volume was created from is not returned by the API
+				rootVolume["snapshot_id"] = d.Get("root_volume.0.snapshot_id")
 
 				_ = d.Set("root_volume", []map[string]interface{}{rootVolume})
 			} else {
@@ -593,21 +1006,33 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 			ServerID: id,
 		}, scw.WithContext(ctx))
 
+		// the cloud-init rendered from a template is kept apart from the user data map
+		isTemplated := len(d.Get("user_data_template").([]interface{})) > 0
 		userData := make(map[string]interface{})
+		renderedCloudInit := ""
 		for key, value := range allUserData.UserData {
 			userDataValue, err := ioutil.ReadAll(value)
 			if err != nil {
 				return diag.FromErr(err)
 			}
+			if isTemplated && key == "cloud-init" {
+				renderedCloudInit = string(userDataValue)
+				// when merged, the cloud-init key of user_data is part of the rendered document and is kept as configured
+				if cloudInit, ok := d.Get("user_data").(map[string]interface{})["cloud-init"]; ok {
+					userData[key] = cloudInit
+				}
+				continue
+			}
 			//if key != "cloud-init" {
 			userData[key] = string(userDataValue)
 			//	} else {
 			//_ = d.Set("cloud_init", string(userDataValue))
 			//}
 		}
-		if len(userData) > 0 {
+		if len(userData) > 0 || isTemplated {
 			_ = d.Set("user_data", userData)
 		}
+		_ = d.Set("user_data_template_rendered", renderedCloudInit)
 
 		////
 		// Read server private networks
@@ -623,6 +1048,14 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 			return diag.FromErr(err)
 		}
 
+		// The DHCP entries are only informative, a failure to list them should not fail the read
+		privateIPs, err := instanceServerPrivateIPs(ctx, vpcgw.NewAPI(meta.(*Meta).scwClient), zone, d.Get("private_network").([]interface{}))
+		if err != nil {
+			l.Warningf("failed to read the private IPs of server %s: %s", d.Id(), err)
+			privateIPs = []string(nil)
+		}
+		_ = d.Set("private_ips", privateIPs)
+
 		return nil
 	}
 	return nil
@@ -635,6 +1068,13 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	// The placement group may not have been known at plan time
+	if d.HasChanges("placement_validation", "tags", "type", "placement_group_id") {
+		if err := validateInstanceServerPlacementConfig(d.Get, zone); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	wantedState := d.Get("state").(string)
 	isStopped := wantedState == InstanceServerStateStopped
 
@@ -669,6 +1109,17 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 
 	if d.HasChange("enable_ipv6") {
 		updateRequest.EnableIPv6 = scw.BoolPtr(d.Get("enable_ipv6").(bool))
+		// IPv6 is only configured on the server when it boots.
+		if !isStopped && server.State == instance.ServerStateRunning && !d.HasChange("state") {
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "instance may need to be rebooted to use the new IPv6 configuration",
+			})
+		}
+	}
+
+	if d.HasChange("protected") {
+		updateRequest.Protected = scw.BoolPtr(d.Get("protected").(bool))
 	}
 
 	if d.HasChange("enable_dynamic_ip") {
@@ -797,7 +1248,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 	////
 	// Update server user data
 	////
-	if d.HasChanges("user_data") {
+	if d.HasChanges("user_data", "user_data_template_rendered") {
 		userDataRequests := &instance.SetAllServerUserDataRequest{
 			Zone:     zone,
 			ServerID: id,
@@ -809,12 +1260,15 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 			for key, value := range userDataMap {
 				userDataRequests.UserData[key] = bytes.NewBufferString(value.(string))
 			}
-			if !isStopped && d.HasChange("user_data.cloud-init") {
-				warnings = append(warnings, diag.Diagnostic{
-					Severity: diag.Warning,
-					Summary:  "instance may need to be rebooted to use the new cloud init config",
-				})
-			}
+		}
+		if rendered, ok := d.GetOk("user_data_template_rendered"); ok {
+			userDataRequests.UserData["cloud-init"] = bytes.NewBufferString(rendered.(string))
+		}
+		if !isStopped && d.HasChanges("user_data.cloud-init", "user_data_template_rendered") && !d.Get("reboot_on_user_data_change").(bool) {
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "instance may need to be rebooted to use the new cloud init config",
+			})
 		}
 
 		_, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
@@ -879,22 +1333,38 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		}
 	}
 	////
-	// Apply changes
+	// Update server type
 	////
+	// The change was validated by customizeDiffInstanceServerTypeChange
+	if d.HasChange("type") {
+		commercialType := d.Get("type").(string)
+		if server.State != instance.ServerStateStopped {
+			err = reachState(ctx, instanceAPI, zone, id, instance.ServerStateStopped)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 
-	if d.HasChange("state") {
-		targetState, err := serverStateExpand(d.Get("state").(string))
+		err = updateServerCommercialType(ctx, meta.(*Meta).scwClient, zone, id, commercialType)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		// reach expected state
-		err = reachState(ctx, instanceAPI, zone, id, targetState)
+	}
+
+	////
+	// Apply changes
+	////
+
+	// Some changes (placement group, local volumes) need the server to be stopped first,
+	// while networking changes (IPv6, dynamic IP) must be applied before the server boots.
+	if isStopped {
+		err = instanceServerReachWantedState(ctx, d, instanceAPI, zone, id)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	_, err = instanceAPI.UpdateServer(updateRequest)
+	_, err = instanceAPI.UpdateServer(updateRequest, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -904,14 +1374,57 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	if !isStopped {
+		err = instanceServerReachWantedState(ctx, d, instanceAPI, zone, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// A server that was just booted already runs the new user data, only a running one needs a reboot.
+	if d.HasChanges("user_data", "user_data_template_rendered") && d.Get("reboot_on_user_data_change").(bool) &&
+		wantedState == InstanceServerStateStarted && server.State == instance.ServerStateRunning && !d.HasChanges("state", "type") {
+		err = instanceAPI.ServerActionAndWait(&instance.ServerActionAndWaitRequest{
+			ServerID:      id,
+			Action:        instance.ServerActionReboot,
+			Zone:          zone,
+			Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutUpdate)),
+			RetryInterval: DefaultWaitRetryInterval,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return append(warnings, resourceScalewayInstanceServerRead(ctx, d, meta)...)
 }
 
+// instanceServerReachWantedState brings the server to the state set in the configuration if it changed,
+// or if the server was stopped to change its type.
+func instanceServerReachWantedState(ctx context.Context, d *schema.ResourceData, instanceAPI *instance.API, zone scw.Zone, id string) error {
+	if !d.HasChanges("state", "type") {
+		return nil
+	}
+
+	targetState, err := serverStateExpand(d.Get("state").(string))
+	if err != nil {
+		return err
+	}
+
+	return reachState(ctx, instanceAPI, zone, id, targetState)
+}
+
 func resourceScalewayInstanceServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	// Fail before detaching anything if the server cannot be deleted
+	if d.Get("protected").(bool) {
+		return diag.Errorf("server %s is protected, set protected to false before deleting it", d.Id())
+	}
+
 	// detach eip to ensure to free eip even if instance won't stop
 	if ipID, ok := d.GetOk("ip_id"); ok {
 		_, err := instanceAPI.UpdateIP(&instance.UpdateIPRequest{
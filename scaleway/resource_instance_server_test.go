@@ -230,6 +230,7 @@ func TestAccScalewayInstanceServer_Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScalewayInstanceServerExists(tt, "scaleway_instance_server.base"),
 					resource.TestCheckResourceAttr("scaleway_instance_server.base", "type", "DEV1-M"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.base", "gpu_count", "0"),
 					resource.TestCheckResourceAttr("scaleway_instance_server.base", "name", "test"),
 					resource.TestCheckResourceAttr("scaleway_instance_server.base", "tags.0", "terraform-test"),
 					resource.TestCheckResourceAttr("scaleway_instance_server.base", "tags.1", "scaleway_instance_server"),
@@ -32,11 +32,12 @@ func resourceScalewayInstanceSnapshot() *schema.Resource {
 				Description: "The name of the snapshot",
 			},
 			"volume_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				Description:  "ID of the volume to take a snapshot from",
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "ID of the volume to take a snapshot from",
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -48,6 +49,11 @@ func resourceScalewayInstanceSnapshot() *schema.Resource {
 				Computed:    true,
 				Description: "The size of the snapshot in gigabyte",
 			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the snapshot",
+			},
 			"tags": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -126,7 +132,16 @@ func resourceScalewayInstanceSnapshotRead(ctx context.Context, d *schema.Resourc
 	_ = d.Set("name", snapshot.Snapshot.Name)
 	_ = d.Set("created_at", snapshot.Snapshot.CreationDate.Format(time.RFC3339))
 	_ = d.Set("type", snapshot.Snapshot.VolumeType.String())
+	_ = d.Set("size_in_gb", int(uint64(snapshot.Snapshot.Size)/gb))
+	_ = d.Set("state", snapshot.Snapshot.State.String())
 	_ = d.Set("tags", snapshot.Snapshot.Tags)
+	_ = d.Set("zone", snapshot.Snapshot.Zone.String())
+	_ = d.Set("organization_id", snapshot.Snapshot.Organization)
+	_ = d.Set("project_id", snapshot.Snapshot.Project)
+	// The base volume is not returned anymore once it is deleted, the snapshot itself is kept
+	if snapshot.Snapshot.BaseVolume != nil {
+		_ = d.Set("volume_id", newZonedIDString(zone, snapshot.Snapshot.BaseVolume.ID))
+	}
 
 	return nil
 }
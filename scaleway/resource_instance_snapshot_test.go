@@ -29,6 +29,10 @@ func TestAccScalewayInstanceSnapshot_BlockVolume(t *testing.T) {
 					}`,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScalewayInstanceSnapShotExists(tt, "scaleway_instance_snapshot.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_snapshot.main", "type", "b_ssd"),
+					resource.TestCheckResourceAttr("scaleway_instance_snapshot.main", "size_in_gb", "20"),
+					resource.TestCheckResourceAttr("scaleway_instance_snapshot.main", "state", "available"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_snapshot.main", "volume_id", "scaleway_instance_volume.main", "id"),
 				),
 			},
 		},
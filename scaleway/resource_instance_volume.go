@@ -48,20 +48,22 @@ func resourceScalewayInstanceVolume() *schema.Resource {
 				ConflictsWith: []string{"from_snapshot_id", "from_volume_id"},
 			},
 			"from_volume_id": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Description:   "Create a copy of an existing volume",
-				ValidateFunc:  validationUUIDorUUIDWithLocality(),
-				ConflictsWith: []string{"from_snapshot_id", "size_in_gb"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "Create a copy of an existing volume",
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				ConflictsWith:    []string{"from_snapshot_id", "size_in_gb"},
 			},
 			"from_snapshot_id": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Description:   "Create a volume based on a image",
-				ValidateFunc:  validationUUIDorUUIDWithLocality(),
-				ConflictsWith: []string{"from_volume_id", "size_in_gb"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "Create a volume based on a image",
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				ConflictsWith:    []string{"from_volume_id", "size_in_gb"},
 			},
 			"server_id": {
 				Type:        schema.TypeString,
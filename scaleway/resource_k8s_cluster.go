@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ func resourceScalewayK8SCluster() *schema.Resource {
 		ReadContext:   resourceScalewayK8SClusterRead,
 		UpdateContext: resourceScalewayK8SClusterUpdate,
 		DeleteContext: resourceScalewayK8SClusterDelete,
+		CustomizeDiff: customizeDiffK8SClusterFeatures,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -154,6 +156,12 @@ func resourceScalewayK8SCluster() *schema.Resource {
 				Default:     false,
 				Description: "Delete additional resources like block volumes and loadbalancers on cluster deletion",
 			},
+			"delete_pools_before_cluster": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the pools of the cluster and wait for their deletion before deleting the cluster",
+			},
 			"region":          regionSchema(),
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
@@ -221,6 +229,55 @@ func resourceScalewayK8SCluster() *schema.Resource {
 	}
 }
 
+// customizeDiffK8SClusterFeatures checks the feature gates and admission plugins against the ones supported by the version
+func customizeDiffK8SClusterFeatures(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.HasChanges("version", "feature_gates", "admission_plugins") {
+		return nil
+	}
+	if !diff.NewValueKnown("version") || !diff.NewValueKnown("feature_gates") || !diff.NewValueKnown("admission_plugins") {
+		return nil
+	}
+
+	featureGates := expandStrings(diff.Get("feature_gates"))
+	admissionPlugins := expandStrings(diff.Get("admission_plugins"))
+	if len(featureGates) == 0 && len(admissionPlugins) == 0 {
+		return nil
+	}
+
+	meta := m.(*Meta)
+	region, exist := meta.scwClient.GetDefaultRegion()
+	if rawRegion, ok := diff.GetOk("region"); ok {
+		region, exist = scw.Region(rawRegion.(string)), true
+	}
+	if !exist {
+		return nil
+	}
+
+	k8sAPI := k8s.NewAPI(meta.scwClient)
+	versionName := diff.Get("version").(string)
+	if len(strings.Split(versionName, ".")) == 2 {
+		latestVersionName, err := k8sGetLatestVersionFromMinor(ctx, k8sAPI, region, versionName)
+		if err != nil {
+			// unknown versions are reported when the cluster is created or upgraded
+			return nil
+		}
+		versionName = latestVersionName
+	}
+
+	version, err := k8sAPI.GetVersion(&k8s.GetVersionRequest{
+		Region:      region,
+		VersionName: versionName,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return err
+	}
+
+	return validateK8SVersionFeatures(version, featureGates, admissionPlugins)
+}
+
 //gocyclo:ignore
 func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
@@ -642,7 +699,9 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 		updateClusterRequestOpenIDConnectConfig.RequiredClaim = scw.StringsPtr(expandStrings(d.Get("open_id_connect_config.0.required_claim")))
 	}
 
-	updateRequest.OpenIDConnectConfig = updateClusterRequestOpenIDConnectConfig
+	if d.HasChange("open_id_connect_config") {
+		updateRequest.OpenIDConnectConfig = updateClusterRequestOpenIDConnectConfig
+	}
 
 	////
 	// Apply Update
@@ -689,6 +748,16 @@ func resourceScalewayK8SClusterDelete(ctx context.Context, d *schema.ResourceDat
 
 	deleteAdditionalResources := d.Get("delete_additional_resources").(bool)
 
+	////
+	// Delete Pools
+	////
+	if d.Get("delete_pools_before_cluster").(bool) {
+		diags := deleteK8SClusterPools(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutDelete))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	////
 	// Delete Cluster
 	////
@@ -793,9 +862,10 @@ func openIDConnectConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"issuer_url": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "URL of the provider which allows the API server to discover public signing keys",
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "URL of the provider which allows the API server to discover public signing keys",
+				ValidateFunc: validation.IsURLWithHTTPS,
 			},
 			"client_id": {
 				Type:        schema.TypeString,
@@ -808,9 +878,10 @@ func openIDConnectConfigSchema() *schema.Resource {
 				Description: "JWT claim to use as the user name",
 			},
 			"username_prefix": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Prefix prepended to username",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Prefix prepended to username",
+				RequiredWith: []string{"open_id_connect_config.0.username_claim"},
 			},
 			"groups_claim": {
 				Type:        schema.TypeList,
@@ -821,16 +892,18 @@ func openIDConnectConfigSchema() *schema.Resource {
 				},
 			},
 			"groups_prefix": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Prefix prepended to group claims",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Prefix prepended to group claims",
+				RequiredWith: []string{"open_id_connect_config.0.groups_claim"},
 			},
 			"required_claim": {
 				Type:        schema.TypeList,
 				Optional:    true,
 				Description: "Multiple key=value pairs that describes a required claim in the ID Token",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^=]+=.+$`), "required claim must be a key=value pair"),
 				},
 			},
 		},
@@ -24,6 +24,7 @@ func resourceScalewayK8SPool() *schema.Resource {
 			Default: schema.DefaultTimeout(defaultK8SPoolTimeout),
 		},
 		SchemaVersion: 0,
+		CustomizeDiff: customizeDiffK8SPoolZone,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -99,6 +100,11 @@ func resourceScalewayK8SPool() *schema.Resource {
 				Default:     true,
 				Description: "Whether to wait for the pool to be ready",
 			},
+			"recycle_nodes": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Changing this value replaces the nodes of the pool one at a time",
+			},
 			"placement_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -185,6 +191,11 @@ func resourceScalewayK8SPool() *schema.Resource {
 							Computed:    true,
 							Description: "The public IPv6 address of the node",
 						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone of the node",
+						},
 					},
 				},
 			},
@@ -197,6 +208,30 @@ func resourceScalewayK8SPool() *schema.Resource {
 	}
 }
 
+func customizeDiffK8SPoolZone(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	rawZone, ok := diff.GetOk("zone")
+	if !ok || !diff.NewValueKnown("zone") || !diff.NewValueKnown("cluster_id") {
+		return nil
+	}
+
+	zone := scw.Zone(rawZone.(string))
+	if !zone.Exists() {
+		// unknown zones are already reported by the zone schema validation
+		return nil
+	}
+
+	region, _, err := parseRegionalID(diff.Get("cluster_id").(string))
+	if err != nil {
+		rawRegion, ok := diff.GetOk("region")
+		if !ok {
+			return nil
+		}
+		region = scw.Region(rawRegion.(string))
+	}
+
+	return validateK8SPoolZone(zone, region)
+}
+
 //gocyclo:ignore
 func resourceScalewayK8SPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
@@ -344,8 +379,8 @@ func resourceScalewayK8SPoolRead(ctx context.Context, d *schema.ResourceData, me
 	_ = d.Set("upgrade_policy", poolUpgradePolicyFlatten(pool))
 
 	if pool.PlacementGroupID != nil {
-		zone := scw.Zone(region + "-1") // Placement groups are zoned resources.
-		_ = d.Set("placement_group_id", newZonedID(zone, *pool.PlacementGroupID).String())
+		// Placement groups are zoned resources, living in the zone of the pool.
+		_ = d.Set("placement_group_id", newZonedID(pool.Zone, *pool.PlacementGroupID).String())
 	}
 
 	return nil
@@ -418,6 +453,18 @@ func resourceScalewayK8SPoolUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("recycle_nodes") && d.Get("recycle_nodes").(string) != "" {
+		pool, err := waitK8SPoolReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = recycleK8SPoolNodes(ctx, k8sAPI, pool, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayK8SPoolRead(ctx, d, meta)
 }
 
@@ -82,10 +82,11 @@ func resourceScalewayLb() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"private_network_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validationUUIDorUUIDWithLocality(),
-							Description:  "The Private Network ID",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validationUUIDorUUIDWithLocality(),
+							DiffSuppressFunc: diffSuppressFuncLocality,
+							Description:      "The Private Network ID",
 						},
 						"static_config": {
 							Description: "Define two IP addresses in the subnet of your private network that will be assigned for the principal and standby node of your load balancer.",
@@ -111,6 +112,17 @@ func resourceScalewayLb() *schema.Resource {
 					},
 				},
 			},
+			"ssl_compatibility_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					lbSDK.SSLCompatibilityLevelSslCompatibilityLevelIntermediate.String(),
+					lbSDK.SSLCompatibilityLevelSslCompatibilityLevelModern.String(),
+					lbSDK.SSLCompatibilityLevelSslCompatibilityLevelOld.String(),
+				}, false),
+				Description: "Enforces minimal SSL version (in SSL/TLS offloading context)",
+			},
 			"region":          regionComputedSchema(),
 			"zone":            zoneSchema(),
 			"organization_id": organizationIDSchema(),
@@ -133,6 +145,10 @@ func resourceScalewayLbCreate(ctx context.Context, d *schema.ResourceData, meta
 		Type:      d.Get("type").(string),
 	}
 
+	if sslCompatibilityLevel, ok := d.GetOk("ssl_compatibility_level"); ok {
+		createReq.SslCompatibilityLevel = lbSDK.SSLCompatibilityLevel(sslCompatibilityLevel.(string))
+	}
+
 	if raw, ok := d.GetOk("tags"); ok {
 		for _, tag := range raw.([]interface{}) {
 			createReq.Tags = append(createReq.Tags, tag.(string))
@@ -174,23 +190,30 @@ func resourceScalewayLbCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, diags := readScalewayLb(ctx, d, meta)
+	return diags
+}
+
+// readScalewayLb sets the state of a load-balancer and returns it, so that the data source can expose more of it.
+// A nil load-balancer is returned when it does not exist anymore.
+func readScalewayLb(ctx context.Context, d *schema.ResourceData, meta interface{}) (*lbSDK.LB, diag.Diagnostics) {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, diag.FromErr(err)
 	}
 
 	lb, err := waitForLbInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
 		if is404Error(err) || is403Error(err) {
 			d.SetId("")
-			return nil
+			return nil, nil
 		}
-		return diag.FromErr(err)
+		return nil, diag.FromErr(err)
 	}
 	// set the region from zone
 	region, err := zone.Region()
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, diag.FromErr(err)
 	}
 
 	_ = d.Set("release_ip", false)
@@ -204,18 +227,19 @@ func resourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta in
 	_ = d.Set("type", strings.ToUpper(lb.Type))
 	_ = d.Set("ip_id", newZonedIDString(zone, lb.IP[0].ID))
 	_ = d.Set("ip_address", lb.IP[0].IPAddress)
+	_ = d.Set("ssl_compatibility_level", lb.SslCompatibilityLevel.String())
 
 	// retrieve attached private networks
 	privateNetworks, err := waitForLBPN(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
 		if is404Error(err) {
-			return nil
+			return lb, nil
 		}
-		return diag.FromErr(err)
+		return nil, diag.FromErr(err)
 	}
 	_ = d.Set("private_network", flattenPrivateNetworkConfigs(privateNetworks))
 
-	return nil
+	return lb, nil
 }
 
 //gocyclo:ignore
@@ -225,12 +249,13 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges("name", "tags") {
+	if d.HasChanges("name", "tags", "ssl_compatibility_level") {
 		req := &lbSDK.ZonedAPIUpdateLBRequest{
-			Zone: zone,
-			LBID: ID,
-			Name: d.Get("name").(string),
-			Tags: expandStrings(d.Get("tags")),
+			Zone:                  zone,
+			LBID:                  ID,
+			Name:                  d.Get("name").(string),
+			Tags:                  expandStrings(d.Get("tags")),
+			SslCompatibilityLevel: lbSDK.SSLCompatibilityLevel(d.Get("ssl_compatibility_level").(string)),
 		}
 
 		_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
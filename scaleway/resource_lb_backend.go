@@ -2,10 +2,13 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -26,6 +29,7 @@ func resourceScalewayLbBackend() *schema.Resource {
 		StateUpgraders: []schema.StateUpgrader{
 			{Version: 0, Type: lbUpgradeV1SchemaType(), Upgrade: lbUpgradeV1SchemaUpgradeFunc},
 		},
+		CustomizeDiff: customizeDiffLbBackendServerTags,
 		Schema: map[string]*schema.Schema{
 			"lb_id": {
 				Type:        schema.TypeString,
@@ -89,6 +93,22 @@ func resourceScalewayLbBackend() *schema.Resource {
 				Optional:    true,
 				Description: "Backend server IP addresses list (IPv4 or IPv6)",
 			},
+			"server_tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Add the public IPs of the instance servers having all these tags to the backend servers",
+			},
+			"resolved_server_ips": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "The IPs of the instance servers matching server_tags",
+			},
 			"send_proxy_v2": {
 				Type:        schema.TypeBool,
 				Description: "Enables PROXY protocol version 2",
@@ -302,7 +322,7 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 			HTTPConfig:      expandLbHCHTTP(d.Get("health_check_http")),
 			HTTPSConfig:     expandLbHCHTTPS(d.Get("health_check_https")),
 		},
-		ServerIP:           expandStrings(d.Get("server_ips")),
+		ServerIP:           expandLbBackendServerIPs(d),
 		SendProxyV2:        d.Get("send_proxy_v2").(bool),
 		ProxyProtocol:      expandLbProxyProtocol(d.Get("proxy_protocol")),
 		TimeoutServer:      timeoutServer,
@@ -330,6 +350,37 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 	return resourceScalewayLbBackendRead(ctx, d, meta)
 }
 
+// customizeDiffLbBackendServerTags resolves server_tags at plan time,
+// so that instance servers added or removed since the last apply show up in the plan.
+func customizeDiffLbBackendServerTags(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	tags := expandStrings(diff.Get("server_tags"))
+	if len(tags) == 0 {
+		if len(diff.Get("resolved_server_ips").([]interface{})) > 0 {
+			return diff.SetNew("resolved_server_ips", []string{})
+		}
+		return nil
+	}
+	if !diff.NewValueKnown("server_tags") || !diff.NewValueKnown("lb_id") {
+		return diff.SetNewComputed("resolved_server_ips")
+	}
+
+	zone, _, err := parseZonedID(diff.Get("lb_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resolvedServerIPs, err := lbBackendResolveServerIPs(ctx, instance.NewAPI(meta.(*Meta).scwClient), zone, tags)
+	if err != nil {
+		return fmt.Errorf("server_tags: %s", err)
+	}
+
+	if !reflect.DeepEqual(resolvedServerIPs, expandStrings(diff.Get("resolved_server_ips"))) {
+		return diff.SetNew("resolved_server_ips", resolvedServerIPs)
+	}
+
+	return nil
+}
+
 func resourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
@@ -356,7 +407,15 @@ func resourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("forward_port_algorithm", flattenLbForwardPortAlgorithm(backend.ForwardPortAlgorithm))
 	_ = d.Set("sticky_sessions", flattenLbStickySessionsType(backend.StickySessions))
 	_ = d.Set("sticky_sessions_cookie_name", backend.StickySessionsCookieName)
-	_ = d.Set("server_ips", backend.Pool)
+	if len(d.Get("server_tags").([]interface{})) > 0 {
+		// servers added by tags are kept apart from the ones configured by IP
+		serverIPs, resolvedServerIPs := splitLbBackendPool(backend.Pool, expandStrings(d.Get("server_ips")))
+		_ = d.Set("server_ips", serverIPs)
+		_ = d.Set("resolved_server_ips", resolvedServerIPs)
+	} else {
+		_ = d.Set("server_ips", backend.Pool)
+		_ = d.Set("resolved_server_ips", nil)
+	}
 	_ = d.Set("send_proxy_v2", backend.SendProxyV2)
 	_ = d.Set("proxy_protocol", flattenLbProxyProtocol(backend.ProxyProtocol))
 	_ = d.Set("timeout_server", flattenDuration(backend.TimeoutServer))
@@ -473,7 +532,7 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 	_, err = lbAPI.SetBackendServers(&lbSDK.ZonedAPISetBackendServersRequest{
 		Zone:      zone,
 		BackendID: ID,
-		ServerIP:  expandStrings(d.Get("server_ips")),
+		ServerIP:  expandLbBackendServerIPs(d),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -31,18 +31,20 @@ func resourceScalewayLbFrontend() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"lb_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "The load-balancer ID",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The load-balancer ID",
 			},
 			"backend_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "The load-balancer backend ID",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The load-balancer backend ID",
 			},
 			"name": {
 				Type:        schema.TypeString,
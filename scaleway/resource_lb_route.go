@@ -27,17 +27,19 @@ func resourceScalewayLbRoute() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"frontend_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "The frontend ID origin of redirection",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The frontend ID origin of redirection",
 			},
 			"backend_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "The backend ID destination of redirection",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The backend ID destination of redirection",
 			},
 			"match_sni": {
 				Type:        schema.TypeString,
@@ -68,6 +70,11 @@ func resourceScalewayLbRouteCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Frontend and Backend must be in the same zone (got %s and %s)", frontZone, backZone)
 	}
 
+	err = checkLbRouteMatch(ctx, lbAPI, backZone, backID, d.Get("match_sni").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createReq := &lbSDK.ZonedAPICreateRouteRequest{
 		Zone:       frontZone,
 		FrontendID: frontID,
@@ -130,6 +137,13 @@ func resourceScalewayLbRouteUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Route and Backend must be in the same zone (got %s and %s)", zone, backZone)
 	}
 
+	if d.HasChanges("match_sni", "backend_id") {
+		err = checkLbRouteMatch(ctx, lbAPI, backZone, backID, d.Get("match_sni").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	req := &lbSDK.ZonedAPIUpdateRouteRequest{
 		Zone:      zone,
 		RouteID:   ID,
@@ -23,6 +23,7 @@ func resourceScalewayObjectBucket() *schema.Resource {
 		ReadContext:   resourceScalewayObjectBucketRead,
 		UpdateContext: resourceScalewayObjectBucketUpdate,
 		DeleteContext: resourceScalewayObjectBucketDelete,
+		CustomizeDiff: customizeDiffObjectBucket,
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
 		},
@@ -97,7 +98,7 @@ func resourceScalewayObjectBucket() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Delete objects in bucket",
+				Description: "Delete objects in bucket, along with their versions, delete markers and incomplete multipart uploads",
 			},
 			"lifecycle_rule": {
 				Type:        schema.TypeList,
@@ -164,6 +165,12 @@ func resourceScalewayObjectBucket() *schema.Resource {
 										ValidateFunc: validation.IntAtLeast(0),
 										Description:  "Specifies the number of days after object creation when the specific rule action takes effect",
 									},
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+										Description:  "Specifies the date after which the specific rule action takes effect (Format ISO 8601), conflicts with days",
+									},
 									"storage_class": {
 										Type:         schema.TypeString,
 										Required:     true,
@@ -176,6 +183,132 @@ func resourceScalewayObjectBucket() *schema.Resource {
 					},
 				},
 			},
+			"replication_configuration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replication configuration of the objects to other buckets, versioning must be enabled on all the buckets",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The role assumed to replicate the objects",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "The replication rules",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringLenBetween(0, 255),
+										Description:  "Unique identifier for the rule",
+									},
+									"prefix": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The prefix identifying one or more objects to which the rule applies",
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3.ReplicationRuleStatusEnabled,
+											s3.ReplicationRuleStatusDisabled,
+										}, false),
+										Description: "Whether the rule is enabled",
+									},
+									"destination": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "The bucket in which the objects are replicated",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:             schema.TypeString,
+													Required:         true,
+													DiffSuppressFunc: diffSuppressFuncLocality,
+													Description:      "The destination bucket, either its name or its ID to use a bucket in another region",
+												},
+												"storage_class": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(TransitionSCWStorageClassValues(), false),
+													Description:  "The storage class of the replicated objects",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_payer": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.PayerBucketOwner,
+					s3.PayerRequester,
+				}, false),
+				Description: "Who pays for the requests and the data transfer of the bucket, BucketOwner or Requester",
+			},
+			"public_access_block": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Block the public access to the bucket and its objects",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Reject the requests setting a public ACL",
+						},
+						"block_public_policy": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Reject the bucket policies granting a public access",
+						},
+						"ignore_public_acls": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Ignore the public ACLs of the bucket and its objects",
+						},
+						"restrict_public_buckets": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Restrict the access to a bucket with a public policy to the bucket owner",
+						},
+					},
+				},
+			},
+			"website":                 objectBucketWebsiteSchema(),
+			"metrics_configuration":   objectBucketConfigurationSchema("A configuration of the request metrics of the bucket"),
+			"analytics_configuration": objectBucketConfigurationSchema("A configuration of the storage class analysis of the bucket"),
+			"object_ownership": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectOwnershipBucketOwnerPreferred,
+					s3.ObjectOwnershipObjectWriter,
+					s3.ObjectOwnershipBucketOwnerEnforced,
+				}, false),
+				Description: "Who owns the objects uploaded to the bucket, BucketOwnerPreferred, ObjectWriter or BucketOwnerEnforced",
+			},
 			"region": regionSchema(),
 			"versioning": {
 				Type:        schema.TypeList,
@@ -198,6 +331,63 @@ func resourceScalewayObjectBucket() *schema.Resource {
 	}
 }
 
+func customizeDiffObjectBucket(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffObjectBucketWebsite(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if err := customizeDiffObjectBucketLifecycle(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	return customizeDiffObjectBucketReplication(ctx, diff, meta)
+}
+
+// customizeDiffObjectBucketLifecycle checks the transitions of the lifecycle rules: a transition has either days or a date,
+// and its storage class must be available in the region of the bucket.
+func customizeDiffObjectBucketLifecycle(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("lifecycle_rule") {
+		return nil
+	}
+
+	// An unset days can only be told apart from days = 0 in the configuration
+	if err := validateObjectBucketLifecycleTransitionTriggers(diff.GetRawConfig()); err != nil {
+		return err
+	}
+
+	region, exist := objectBucketDiffRegion(diff, meta)
+	if !exist {
+		return nil
+	}
+
+	for _, rawRule := range diff.Get("lifecycle_rule").([]interface{}) {
+		rule := rawRule.(map[string]interface{})
+		transitions, ok := rule["transition"].(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, rawTransition := range transitions.List() {
+			storageClass := rawTransition.(map[string]interface{})["storage_class"].(string)
+			if storageClass == "" {
+				continue
+			}
+			if err := validateObjectStorageClassRegion(storageClass, region); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func customizeDiffObjectBucketWebsite(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.HasChange("website") || !diff.NewValueKnown("website") {
+		return nil
+	}
+
+	return validateObjectBucketWebsiteRoutingRules(diff.Get("website.0.routing_rule").([]interface{}))
+}
+
 func resourceScalewayObjectBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	bucketName := d.Get("name").(string)
 	acl := d.Get("acl").(string)
@@ -239,11 +429,12 @@ func resourceScalewayObjectBucketCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceScalewayObjectBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	s3Client, _, bucketName, err := s3ClientWithRegionAndName(meta, d.Id())
+	s3Client, region, bucketName, err := s3ClientWithRegionAndName(meta, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if d.HasChange("acl") {
 		acl := d.Get("acl").(string)
 
@@ -263,6 +454,27 @@ func resourceScalewayObjectBucketUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.HasChange("request_payer") {
+		diags = append(diags, resourceScalewayObjectBucketRequestPayerUpdate(ctx, s3Client, region, d)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("public_access_block") {
+		diags = append(diags, resourceScalewayObjectBucketPublicAccessBlockUpdate(ctx, s3Client, region, d)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("object_ownership") {
+		diags = append(diags, resourceScalewayObjectBucketOwnershipUpdate(ctx, s3Client, region, d)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	if d.HasChange("tags") {
 		tagsSet := expandObjectBucketTags(d.Get("tags"))
 
@@ -295,7 +507,32 @@ func resourceScalewayObjectBucketUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	return resourceScalewayObjectBucketRead(ctx, d, meta)
+	if d.HasChanges("replication_configuration", "versioning") {
+		diags = append(diags, resourceScalewayObjectBucketReplicationUpdate(ctx, s3Client, region, d)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("website") {
+		diags = append(diags, resourceScalewayObjectBucketWebsiteUpdate(ctx, s3Client, region, d)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("metrics_configuration") {
+		diags = append(diags, resourceScalewayObjectBucketMetricsUpdate(ctx, s3Client, region, d)...)
+	}
+
+	if d.HasChange("analytics_configuration") {
+		diags = append(diags, resourceScalewayObjectBucketAnalyticsUpdate(ctx, s3Client, region, d)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceScalewayObjectBucketRead(ctx, d, meta)...)
 }
 
 //gocyclo:ignore
@@ -377,7 +614,10 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.S3, d *schema.R
 			for _, transition := range transitions {
 				transition := transition.(map[string]interface{})
 				i := &s3.Transition{}
-				if val, ok := transition["days"].(int); ok && val >= 0 {
+				// Both days and date are checked at plan time by customizeDiffObjectBucketLifecycle
+				if val, ok := transition["date"].(string); ok && val != "" {
+					i.Date = expandTimePtr(val)
+				} else if val, ok := transition["days"].(int); ok && val >= 0 {
 					i.Days = aws.Int64(int64(val))
 				}
 				if val, ok := transition["storage_class"].(string); ok && val != "" {
@@ -487,6 +727,98 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 	}
 	_ = d.Set("versioning", flattenObjectBucketVersioning(versioningResponse))
 
+	// The configurations not implemented by the object storage of every region are read concurrently
+	currentReplication := d.Get("replication_configuration").([]interface{})
+	err = readObjectBucketOptionalConfigurations(ctx, d, []objectBucketOptionalConfiguration{
+		{
+			key: "request_payer",
+			read: func() (interface{}, error) {
+				res, err := s3Client.GetBucketRequestPaymentWithContext(ctx, &s3.GetBucketRequestPaymentInput{
+					Bucket: scw.StringPtr(bucketName),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return aws.StringValue(res.Payer), nil
+			},
+		},
+		{
+			key:          "public_access_block",
+			notFoundCode: ErrCodeNoSuchPublicAccessBlockConfiguration,
+			read: func() (interface{}, error) {
+				res, err := s3Client.GetPublicAccessBlockWithContext(ctx, &s3.GetPublicAccessBlockInput{
+					Bucket: scw.StringPtr(bucketName),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return flattenObjectBucketPublicAccessBlock(res.PublicAccessBlockConfiguration), nil
+			},
+		},
+		{
+			key:          "website",
+			notFoundCode: ErrCodeNoSuchWebsiteConfiguration,
+			read: func() (interface{}, error) {
+				res, err := s3Client.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{
+					Bucket: scw.StringPtr(bucketName),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return flattenObjectBucketWebsite(res), nil
+			},
+		},
+		{
+			key: "metrics_configuration",
+			read: func() (interface{}, error) {
+				configurations, err := listObjectBucketMetricsConfigurations(ctx, s3Client, bucketName)
+				if err != nil {
+					return nil, err
+				}
+				return flattenObjectBucketMetricsConfigurations(configurations), nil
+			},
+		},
+		{
+			key: "analytics_configuration",
+			read: func() (interface{}, error) {
+				configurations, err := listObjectBucketAnalyticsConfigurations(ctx, s3Client, bucketName)
+				if err != nil {
+					return nil, err
+				}
+				return flattenObjectBucketAnalyticsConfigurations(configurations), nil
+			},
+		},
+		{
+			key:          "object_ownership",
+			notFoundCode: ErrCodeOwnershipControlsNotFound,
+			read: func() (interface{}, error) {
+				res, err := s3Client.GetBucketOwnershipControlsWithContext(ctx, &s3.GetBucketOwnershipControlsInput{
+					Bucket: scw.StringPtr(bucketName),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return flattenObjectBucketOwnership(res.OwnershipControls), nil
+			},
+		},
+		{
+			key:          "replication_configuration",
+			notFoundCode: ErrCodeReplicationConfigurationNotFound,
+			read: func() (interface{}, error) {
+				res, err := s3Client.GetBucketReplicationWithContext(ctx, &s3.GetBucketReplicationInput{
+					Bucket: scw.StringPtr(bucketName),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return flattenObjectBucketReplication(res.ReplicationConfiguration, currentReplication), nil
+			},
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Read the lifecycle configuration
 	lifecycleResponse, err := retryOnAWSCode(ctx, s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return s3Client.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
@@ -568,6 +900,9 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 					if v.Days != nil {
 						t["days"] = int(aws.Int64Value(v.Days))
 					}
+					if v.Date != nil {
+						t["date"] = flattenTime(v.Date)
+					}
 					if v.StorageClass != nil {
 						t["storage_class"] = aws.StringValue(v.StorageClass)
 					}
@@ -601,14 +936,30 @@ func resourceScalewayObjectBucketDelete(ctx context.Context, d *schema.ResourceD
 	}
 
 	if isS3Err(err, ErrCodeBucketNotEmpty, "") {
-		if d.Get("force_destroy").(bool) {
-			err = deleteS3ObjectVersions(ctx, s3Client, bucketName, true)
+		if !d.Get("force_destroy").(bool) {
+			return diag.Errorf("bucket %s is not empty: delete its objects, their versions and delete markers, or set force_destroy to delete them along with the bucket", bucketName)
+		}
+
+		err = deleteS3ObjectVersions(ctx, s3Client, bucketName, true)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error S3 bucket force_destroy: %s", err))
+		}
+
+		// The incomplete multipart uploads are only listed when they keep the bucket from being deleted
+		_, err = s3Client.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+			Bucket: scw.StringPtr(bucketName),
+		})
+		if isS3Err(err, ErrCodeBucketNotEmpty, "") {
+			err = abortS3MultipartUploads(ctx, s3Client, bucketName)
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("error S3 bucket force_destroy: %s", err))
 			}
 			// Try to delete bucket again after deleting objects
 			return resourceScalewayObjectBucketDelete(ctx, d, meta)
 		}
+		if isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+			return nil
+		}
 	}
 	if err != nil {
 		return diag.FromErr(err)
@@ -636,6 +987,186 @@ func resourceScalewayObjectBucketVersioningUpdate(ctx context.Context, s3conn *s
 	return nil
 }
 
+func resourceScalewayObjectBucketRequestPayerUpdate(ctx context.Context, s3conn *s3.S3, region scw.Region, d *schema.ResourceData) diag.Diagnostics {
+	payer := d.Get("request_payer").(string)
+	if payer == "" {
+		return nil
+	}
+	bucketName := d.Get("name").(string)
+
+	i := &s3.PutBucketRequestPaymentInput{
+		Bucket: scw.StringPtr(bucketName),
+		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{
+			Payer: scw.StringPtr(payer),
+		},
+	}
+	tflog.Debug(ctx, fmt.Sprintf("S3 put bucket request payment: %#v", i))
+
+	_, err := s3conn.PutBucketRequestPaymentWithContext(ctx, i)
+
+	return objectBucketOptionalConfigurationDiags("request_payer", region, err)
+}
+
+func resourceScalewayObjectBucketPublicAccessBlockUpdate(ctx context.Context, s3conn *s3.S3, region scw.Region, d *schema.ResourceData) diag.Diagnostics {
+	bucketName := d.Get("name").(string)
+
+	var err error
+	publicAccessBlock := expandObjectBucketPublicAccessBlock(d.Get("public_access_block").([]interface{}))
+	if publicAccessBlock == nil {
+		_, err = s3conn.DeletePublicAccessBlockWithContext(ctx, &s3.DeletePublicAccessBlockInput{
+			Bucket: scw.StringPtr(bucketName),
+		})
+	} else {
+		i := &s3.PutPublicAccessBlockInput{
+			Bucket:                         scw.StringPtr(bucketName),
+			PublicAccessBlockConfiguration: publicAccessBlock,
+		}
+		tflog.Debug(ctx, fmt.Sprintf("S3 put public access block: %#v", i))
+		_, err = s3conn.PutPublicAccessBlockWithContext(ctx, i)
+	}
+
+	return objectBucketOptionalConfigurationDiags("public_access_block", region, err)
+}
+
+func resourceScalewayObjectBucketWebsiteUpdate(ctx context.Context, s3conn *s3.S3, region scw.Region, d *schema.ResourceData) diag.Diagnostics {
+	bucketName := d.Get("name").(string)
+
+	var err error
+	website := expandObjectBucketWebsite(d.Get("website").([]interface{}))
+	if website == nil {
+		_, err = s3conn.DeleteBucketWebsiteWithContext(ctx, &s3.DeleteBucketWebsiteInput{
+			Bucket: scw.StringPtr(bucketName),
+		})
+	} else {
+		i := &s3.PutBucketWebsiteInput{
+			Bucket:               scw.StringPtr(bucketName),
+			WebsiteConfiguration: website,
+		}
+		tflog.Debug(ctx, fmt.Sprintf("S3 put bucket website: %#v", i))
+		_, err = s3conn.PutBucketWebsiteWithContext(ctx, i)
+	}
+
+	return objectBucketOptionalConfigurationDiags("website", region, err)
+}
+
+func resourceScalewayObjectBucketOwnershipUpdate(ctx context.Context, s3conn *s3.S3, region scw.Region, d *schema.ResourceData) diag.Diagnostics {
+	ownership := d.Get("object_ownership").(string)
+	if ownership == "" {
+		return nil
+	}
+	bucketName := d.Get("name").(string)
+
+	i := &s3.PutBucketOwnershipControlsInput{
+		Bucket: scw.StringPtr(bucketName),
+		OwnershipControls: &s3.OwnershipControls{
+			Rules: []*s3.OwnershipControlsRule{{ObjectOwnership: scw.StringPtr(ownership)}},
+		},
+	}
+	tflog.Debug(ctx, fmt.Sprintf("S3 put bucket ownership controls: %#v", i))
+
+	_, err := s3conn.PutBucketOwnershipControlsWithContext(ctx, i)
+
+	return objectBucketOptionalConfigurationDiags("object_ownership", region, err)
+}
+
+func listObjectBucketMetricsConfigurations(ctx context.Context, s3conn *s3.S3, bucketName string) ([]*s3.MetricsConfiguration, error) {
+	configurations := []*s3.MetricsConfiguration(nil)
+	input := &s3.ListBucketMetricsConfigurationsInput{
+		Bucket: scw.StringPtr(bucketName),
+	}
+	for {
+		res, err := s3conn.ListBucketMetricsConfigurationsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		configurations = append(configurations, res.MetricsConfigurationList...)
+		if !aws.BoolValue(res.IsTruncated) {
+			return configurations, nil
+		}
+		input.ContinuationToken = res.NextContinuationToken
+	}
+}
+
+func listObjectBucketAnalyticsConfigurations(ctx context.Context, s3conn *s3.S3, bucketName string) ([]*s3.AnalyticsConfiguration, error) {
+	configurations := []*s3.AnalyticsConfiguration(nil)
+	input := &s3.ListBucketAnalyticsConfigurationsInput{
+		Bucket: scw.StringPtr(bucketName),
+	}
+	for {
+		res, err := s3conn.ListBucketAnalyticsConfigurationsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		configurations = append(configurations, res.AnalyticsConfigurationList...)
+		if !aws.BoolValue(res.IsTruncated) {
+			return configurations, nil
+		}
+		input.ContinuationToken = res.NextContinuationToken
+	}
+}
+
+// resourceScalewayObjectBucketMetricsUpdate puts the metrics configurations and deletes the removed ones.
+// Where the object storage does not support metrics, the configuration is ignored with a warning.
+func resourceScalewayObjectBucketMetricsUpdate(ctx context.Context, s3conn *s3.S3, region scw.Region, d *schema.ResourceData) diag.Diagnostics {
+	bucketName := d.Get("name").(string)
+	oldConfigurations, newConfigurations := d.GetChange("metrics_configuration")
+
+	var err error
+	for _, id := range objectBucketConfigurationsToDelete(oldConfigurations.([]interface{}), newConfigurations.([]interface{})) {
+		_, err = s3conn.DeleteBucketMetricsConfigurationWithContext(ctx, &s3.DeleteBucketMetricsConfigurationInput{
+			Bucket: scw.StringPtr(bucketName),
+			Id:     scw.StringPtr(id),
+		})
+		if err != nil {
+			break
+		}
+	}
+	for _, raw := range newConfigurations.([]interface{}) {
+		if err != nil {
+			break
+		}
+		configuration := expandObjectBucketMetricsConfiguration(raw)
+		_, err = s3conn.PutBucketMetricsConfigurationWithContext(ctx, &s3.PutBucketMetricsConfigurationInput{
+			Bucket:               scw.StringPtr(bucketName),
+			Id:                   configuration.Id,
+			MetricsConfiguration: configuration,
+		})
+	}
+
+	return objectBucketOptionalConfigurationDiags("metrics_configuration", region, err)
+}
+
+// resourceScalewayObjectBucketAnalyticsUpdate puts the analytics configurations and deletes the removed ones.
+// Where the object storage does not support analytics, the configuration is ignored with a warning.
+func resourceScalewayObjectBucketAnalyticsUpdate(ctx context.Context, s3conn *s3.S3, region scw.Region, d *schema.ResourceData) diag.Diagnostics {
+	bucketName := d.Get("name").(string)
+	oldConfigurations, newConfigurations := d.GetChange("analytics_configuration")
+
+	var err error
+	for _, id := range objectBucketConfigurationsToDelete(oldConfigurations.([]interface{}), newConfigurations.([]interface{})) {
+		_, err = s3conn.DeleteBucketAnalyticsConfigurationWithContext(ctx, &s3.DeleteBucketAnalyticsConfigurationInput{
+			Bucket: scw.StringPtr(bucketName),
+			Id:     scw.StringPtr(id),
+		})
+		if err != nil {
+			break
+		}
+	}
+	for _, raw := range newConfigurations.([]interface{}) {
+		if err != nil {
+			break
+		}
+		configuration := expandObjectBucketAnalyticsConfiguration(raw)
+		_, err = s3conn.PutBucketAnalyticsConfigurationWithContext(ctx, &s3.PutBucketAnalyticsConfigurationInput{
+			Bucket:                 scw.StringPtr(bucketName),
+			Id:                     configuration.Id,
+			AnalyticsConfiguration: configuration,
+		})
+	}
+
+	return objectBucketOptionalConfigurationDiags("analytics_configuration", region, err)
+}
+
 func resourceScalewayS3BucketCorsUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	bucketName := d.Get("name").(string)
 	rawCors := d.Get("cors_rule").([]interface{})
@@ -670,3 +1201,87 @@ func resourceScalewayS3BucketCorsUpdate(ctx context.Context, s3conn *s3.S3, d *s
 
 	return nil
 }
+
+func resourceScalewayObjectBucketReplicationUpdate(ctx context.Context, s3conn *s3.S3, region scw.Region, d *schema.ResourceData) diag.Diagnostics {
+	bucketName := d.Get("name").(string)
+	rawReplication := d.Get("replication_configuration").([]interface{})
+
+	if len(rawReplication) == 0 {
+		if !d.HasChange("replication_configuration") {
+			return nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("S3 bucket: %s, delete replication", bucketName))
+
+		_, err := s3conn.DeleteBucketReplicationWithContext(ctx, &s3.DeleteBucketReplicationInput{
+			Bucket: scw.StringPtr(bucketName),
+		})
+		if isS3Err(err, ErrCodeReplicationConfigurationNotFound, "") {
+			return nil
+		}
+
+		return objectBucketOptionalConfigurationDiags("replication_configuration", region, err)
+	}
+
+	i := &s3.PutBucketReplicationInput{
+		Bucket:                   scw.StringPtr(bucketName),
+		ReplicationConfiguration: expandObjectBucketReplication(rawReplication),
+	}
+	tflog.Debug(ctx, fmt.Sprintf("S3 bucket: %s, put replication: %#v", bucketName, i))
+
+	_, err := s3conn.PutBucketReplicationWithContext(ctx, i)
+
+	return objectBucketOptionalConfigurationDiags("replication_configuration", region, err)
+}
+
+// customizeDiffObjectBucketReplication checks that versioning is enabled on the bucket and on every destination bucket.
+// A destination bucket which does not exist yet may be created in the same apply, it is left to the API.
+func customizeDiffObjectBucketReplication(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("replication_configuration", "versioning") || !diff.NewValueKnown("replication_configuration") {
+		return nil
+	}
+	rawRules := diff.Get("replication_configuration.0.rule").([]interface{})
+	if len(rawRules) == 0 {
+		return nil
+	}
+
+	if diff.NewValueKnown("versioning") && !diff.Get("versioning.0.enabled").(bool) {
+		return fmt.Errorf("versioning must be enabled on bucket %s to replicate its objects", diff.Get("name").(string))
+	}
+
+	region, exist := objectBucketDiffRegion(diff, meta)
+	if !exist {
+		return nil
+	}
+
+	for _, rawRule := range rawRules {
+		rule := rawRule.(map[string]interface{})
+		for _, rawDestination := range rule["destination"].([]interface{}) {
+			destination := rawDestination.(map[string]interface{})
+			if destination["bucket"].(string) == "" {
+				continue
+			}
+
+			s3Client, _, destinationName, err := s3ClientWithRegionAndName(meta, datasourceNewRegionalizedID(destination["bucket"], region))
+			if err != nil {
+				return err
+			}
+
+			versioning, err := s3Client.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
+				Bucket: scw.StringPtr(destinationName),
+			})
+			if err != nil {
+				if isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+					continue
+				}
+				return fmt.Errorf("couldn't read versioning of replication destination bucket %s: %s", destinationName, err)
+			}
+
+			if aws.StringValue(versioning.Status) != s3.BucketVersioningStatusEnabled {
+				return fmt.Errorf("versioning must be enabled on replication destination bucket %s", destinationName)
+			}
+		}
+	}
+
+	return nil
+}
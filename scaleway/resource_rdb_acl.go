@@ -5,6 +5,7 @@ import (
 	"context"
 	"net"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,11 +29,12 @@ func resourceScalewayRdbACL() *schema.Resource {
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "Instance on which the ACL is applied",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "Instance on which the ACL is applied",
 			},
 			"acl_rules": {
 				Type:        schema.TypeList,
@@ -62,12 +64,22 @@ func resourceScalewayRdbACL() *schema.Resource {
 }
 
 func resourceScalewayRdbACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	instanceID := d.Get("instance_id").(string)
-	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, instanceID)
+	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// instance_id may be given without its region, in which case the resource one is used
+	instanceID := datasourceNewRegionalizedID(d.Get("instance_id"), region)
+	region, ID, err := parseRegionalID(instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKey := rdbInstanceMutexKey(region, ID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, expandID(instanceID), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -94,7 +106,7 @@ func resourceScalewayRdbACLCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalewayRdbACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	rdbAPI, region, instanceID, err := rdbAPIWithRegionAndID(meta, d.Get("instance_id").(string))
+	rdbAPI, region, instanceID, err := rdbAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -131,6 +143,10 @@ func resourceScalewayRdbACLUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil && !is404Error(err) {
 		return diag.FromErr(err)
@@ -166,6 +182,11 @@ func resourceScalewayRdbACLDelete(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	aclRuleIPs := make([]string, 0)
 	aclRules, err := rdbACLExpand(d.Get("acl_rules").([]interface{}))
 	if err != nil {
@@ -228,11 +249,34 @@ func rdbACLRulesFlatten(rules []*rdb.ACLRule) []map[string]interface{} {
 		res = append(res, r)
 	}
 
-	sort.Slice(res, func(i, j int) bool {
-		ipI, _, _ := net.ParseCIDR(res[i]["ip"].(string))
-		ipJ, _, _ := net.ParseCIDR(res[j]["ip"].(string))
-		return bytes.Compare(ipI, ipJ) < 0
+	sort.SliceStable(res, func(i, j int) bool {
+		return compareRdbACLRuleIP(res[i]["ip"].(string), res[j]["ip"].(string)) < 0
 	})
 
 	return res
 }
+
+// compareRdbACLRuleIP orders two CIDRs by address then by prefix length, so that the rules keep the same order
+// whatever the order returned by the API.
+func compareRdbACLRuleIP(a, b string) int {
+	ipA, netA, errA := net.ParseCIDR(a)
+	ipB, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+
+	if c := bytes.Compare(ipA.To16(), ipB.To16()); c != 0 {
+		return c
+	}
+
+	onesA, _ := netA.Mask.Size()
+	onesB, _ := netB.Mask.Size()
+	switch {
+	case onesA < onesB:
+		return -1
+	case onesA > onesB:
+		return 1
+	}
+
+	return 0
+}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -67,6 +66,10 @@ func resourceScalewayRdbDatabaseCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -79,15 +82,10 @@ func resourceScalewayRdbDatabaseCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	var db *rdb.Database
-	//  wrapper around StateChangeConf that will just retry the database creation
-	err = resource.RetryContext(context.Background(), createDatabaseTimeout, func() *resource.RetryError {
+	err = rdbRetryOnConflict(ctx, rdbAPI, region, instanceID, createDatabaseTimeout, func() error {
 		currentDB, errCreateDB := rdbAPI.CreateDatabase(createReq, scw.WithContext(ctx))
 		if errCreateDB != nil {
-			// WIP: Issue on creation/write database. Need a database stable status
-			if is409Error(errCreateDB) {
-				return resource.RetryableError(errCreateDB)
-			}
-			return resource.NonRetryableError(errCreateDB)
+			return errCreateDB
 		}
 		// set database information
 		db = currentDB
@@ -157,6 +155,10 @@ func resourceScalewayRdbDatabaseDelete(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
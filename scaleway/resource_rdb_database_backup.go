@@ -0,0 +1,241 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayRdbDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayRdbDatabaseBackupCreate,
+		ReadContext:   resourceScalewayRdbDatabaseBackupRead,
+		UpdateContext: resourceScalewayRdbDatabaseBackupUpdate,
+		DeleteContext: resourceScalewayRdbDatabaseBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultRdbInstanceTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "Instance on which the database to backup is",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the database to backup",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the backup",
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: diffSuppressFuncTimeRFC3339,
+				Description:      "Expiration date of the backup (Format ISO 8601), the API cannot clear it once set",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the backup",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the backup in bytes",
+			},
+			"download_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "URL to download the exported backup",
+			},
+			"download_url_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the download URL",
+			},
+			"instance_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the instance of the backup",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date (Format ISO 8601)",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Updated date (Format ISO 8601)",
+			},
+			// Common
+			"region": regionSchema(),
+		},
+	}
+}
+
+func resourceScalewayRdbDatabaseBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region, instanceID, err := parseRegionalID(datasourceNewRegionalizedID(d.Get("instance_id"), region))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
+	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createReq := &rdb.CreateDatabaseBackupRequest{
+		Region:       region,
+		InstanceID:   instanceID,
+		DatabaseName: d.Get("database_name").(string),
+		Name:         expandOrGenerateString(d.Get("name"), "backup"),
+		ExpiresAt:    expandTimePtr(d.Get("expires_at")),
+	}
+
+	var backup *rdb.DatabaseBackup
+	err = rdbRetryOnConflict(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate), func() error {
+		backup, err = rdbAPI.CreateDatabaseBackup(createReq, scw.WithContext(ctx))
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newRegionalIDString(region, backup.ID))
+
+	err = waitForRDBDatabaseBackupReady(ctx, rdbAPI, region, backup.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The download URL is only available once the backup is exported
+	_, err = rdbAPI.ExportDatabaseBackup(&rdb.ExportDatabaseBackupRequest{
+		Region:           region,
+		DatabaseBackupID: backup.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = waitForRDBDatabaseBackupReady(ctx, rdbAPI, region, backup.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayRdbDatabaseBackupRead(ctx, d, meta)
+}
+
+func resourceScalewayRdbDatabaseBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backup, err := rdbAPI.GetDatabaseBackup(&rdb.GetDatabaseBackupRequest{
+		Region:           region,
+		DatabaseBackupID: ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	size := 0
+	if backup.Size != nil {
+		size = int(*backup.Size)
+	}
+
+	_ = d.Set("instance_id", newRegionalIDString(region, backup.InstanceID))
+	_ = d.Set("database_name", backup.DatabaseName)
+	_ = d.Set("name", backup.Name)
+	_ = d.Set("expires_at", flattenTime(backup.ExpiresAt))
+	_ = d.Set("status", backup.Status.String())
+	_ = d.Set("size", size)
+	_ = d.Set("download_url", flattenStringPtr(backup.DownloadURL))
+	_ = d.Set("download_url_expires_at", flattenTime(backup.DownloadURLExpiresAt))
+	_ = d.Set("instance_name", backup.InstanceName)
+	_ = d.Set("created_at", flattenTime(backup.CreatedAt))
+	_ = d.Set("updated_at", flattenTime(backup.UpdatedAt))
+	_ = d.Set("region", string(region))
+
+	return nil
+}
+
+func resourceScalewayRdbDatabaseBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "expires_at") {
+		_, err = rdbAPI.UpdateDatabaseBackup(&rdb.UpdateDatabaseBackupRequest{
+			Region:           region,
+			DatabaseBackupID: ID,
+			Name:             expandStringPtr(d.Get("name")),
+			ExpiresAt:        expandTimePtr(d.Get("expires_at")),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayRdbDatabaseBackupRead(ctx, d, meta)
+}
+
+func resourceScalewayRdbDatabaseBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForRDBDatabaseBackup(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_, err = rdbAPI.DeleteDatabaseBackup(&rdb.DeleteDatabaseBackupRequest{
+		Region:           region,
+		DatabaseBackupID: ID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
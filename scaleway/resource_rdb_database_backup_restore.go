@@ -0,0 +1,139 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// resourceScalewayRdbDatabaseBackupRestore restores a backup when it is created.
+// There is nothing to read back nor to delete: the restored data lives in the target database,
+// so the state does not depend on the backup still existing.
+func resourceScalewayRdbDatabaseBackupRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayRdbDatabaseBackupRestoreCreate,
+		ReadContext:   resourceScalewayRdbDatabaseBackupRestoreRead,
+		DeleteContext: resourceScalewayRdbDatabaseBackupRestoreDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultRdbInstanceTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"backup_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "Backup to restore",
+			},
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "Instance on which the backup is restored",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Database in which the backup is restored, defaults to the database of the backup",
+			},
+			// Common
+			"region": regionSchema(),
+		},
+	}
+}
+
+func resourceScalewayRdbDatabaseBackupRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region, backupID, err := parseRegionalID(datasourceNewRegionalizedID(d.Get("backup_id"), region))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, instanceID, err := parseRegionalID(datasourceNewRegionalizedID(d.Get("instance_id"), region))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
+	err = waitForRDBDatabaseBackupReady(ctx, rdbAPI, region, backupID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = rdbRetryOnConflict(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := rdbAPI.RestoreDatabaseBackup(&rdb.RestoreDatabaseBackupRequest{
+			Region:           region,
+			DatabaseBackupID: backupID,
+			InstanceID:       instanceID,
+			DatabaseName:     expandStringPtr(d.Get("database_name")),
+		}, scw.WithContext(ctx))
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceScalewayRdbDatabaseBackupRestoreID(region, instanceID, backupID))
+
+	err = waitForRDBDatabaseBackupReady(ctx, rdbAPI, region, backupID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayRdbDatabaseBackupRestoreRead(ctx, d, meta)
+}
+
+func resourceScalewayRdbDatabaseBackupRestoreRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	region, instanceID, backupID, err := resourceScalewayRdbDatabaseBackupRestoreParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("backup_id", newRegionalIDString(region, backupID))
+	_ = d.Set("instance_id", newRegionalIDString(region, instanceID))
+	_ = d.Set("region", string(region))
+
+	return nil
+}
+
+func resourceScalewayRdbDatabaseBackupRestoreDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// Build the resource identifier
+// The resource identifier format is "Region/InstanceId/BackupId"
+func resourceScalewayRdbDatabaseBackupRestoreID(region scw.Region, instanceID string, backupID string) string {
+	return fmt.Sprintf("%s/%s/%s", region, instanceID, backupID)
+}
+
+// Extract instance ID and backup ID from the resource identifier.
+// The resource identifier format is "Region/InstanceId/BackupId"
+func resourceScalewayRdbDatabaseBackupRestoreParseID(resourceID string) (region scw.Region, instanceID string, backupID string, err error) {
+	idParts := strings.Split(resourceID, "/")
+	if len(idParts) != 3 {
+		return "", "", "", fmt.Errorf("can't parse backup restore resource id: %s", resourceID)
+	}
+	return scw.Region(idParts[0]), idParts[1], idParts[2], nil
+}
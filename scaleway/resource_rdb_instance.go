@@ -62,16 +62,18 @@ func resourceScalewayRdbInstance() *schema.Resource {
 				Description: "Disable automated backup for the database instance",
 			},
 			"backup_schedule_frequency": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Backup schedule frequency in hours",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 24),
+				Description:  "Backup schedule frequency in hours",
 			},
 			"backup_schedule_retention": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "Backup schedule retention in days",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 365),
+				Description:  "Backup schedule retention in days",
 			},
 			"backup_same_region": {
 				Type:        schema.TypeBool,
@@ -96,9 +98,10 @@ func resourceScalewayRdbInstance() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Description: "Map of engine settings to be set.",
-				Computed:    true,
-				Optional:    true,
+				Description:      "Map of engine settings to be set. Removing a setting keeps its current value on the instance",
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: diffSuppressFuncRdbInstanceSettings,
 			},
 			"tags": {
 				Type: schema.TypeList,
@@ -134,14 +137,15 @@ func resourceScalewayRdbInstance() *schema.Resource {
 						"ip_net": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.IsCIDR,
+							ValidateFunc: validationPrivateServiceIPNet(),
 							Description:  "The ip net of your private network",
 						},
 						"pn_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validationUUIDorUUIDWithLocality(),
-							Description:  "The private network ID",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validationUUIDorUUIDWithLocality(),
+							DiffSuppressFunc: diffSuppressFuncLocality,
+							Description:      "The private network ID",
 						},
 						// Computed
 						"endpoint_id": {
@@ -449,6 +453,10 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, ID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	req := &rdb.UpdateInstanceRequest{
 		Region:     region,
 		InstanceID: ID,
@@ -612,7 +620,13 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		// get endpoints to detach. It will handle only private networks
 		endPointsToRemove, err := endpointsToRemove(res.Endpoints, d.Get("private_network"))
 		if err != nil {
-			diag.FromErr(err)
+			return diag.FromErr(err)
+		}
+		keptEndpoints := map[string]bool{}
+		for _, e := range res.Endpoints {
+			if e.PrivateNetwork != nil && !endPointsToRemove[e.ID] {
+				keptEndpoints[e.PrivateNetwork.PrivateNetworkID] = true
+			}
 		}
 		for endPointID, remove := range endPointsToRemove {
 			if remove {
@@ -621,7 +635,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 						EndpointID: endPointID, Region: region},
 					scw.WithContext(ctx))
 				if err != nil {
-					diag.FromErr(err)
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -640,11 +654,15 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 				return diag.FromErr(err)
 			}
 			for _, e := range privateEndpoints {
+				// an endpoint left untouched is still attached
+				if keptEndpoints[e.PrivateNetwork.PrivateNetworkID] {
+					continue
+				}
 				_, err := rdbAPI.CreateEndpoint(
 					&rdb.CreateEndpointRequest{Region: region, InstanceID: ID, EndpointSpec: e},
 					scw.WithContext(ctx))
 				if err != nil {
-					diag.FromErr(err)
+					return diag.FromErr(err)
 				}
 			}
 		}
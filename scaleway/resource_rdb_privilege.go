@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
@@ -67,6 +66,10 @@ func resourceScalewayRdbPrivilegeCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -81,20 +84,9 @@ func resourceScalewayRdbPrivilegeCreate(ctx context.Context, d *schema.ResourceD
 		Permission:   rdb.Permission(d.Get("permission").(string)),
 	}
 
-	//  wrapper around StateChangeConf that will just retry  write on database
-	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, errSetPrivilege := rdbAPI.SetPrivilege(createReq, scw.WithContext(ctx))
-		if errSetPrivilege != nil {
-			if is409Error(errSetPrivilege) {
-				_, errWait := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
-				if errWait != nil {
-					return resource.NonRetryableError(errWait)
-				}
-				return resource.RetryableError(errSetPrivilege)
-			}
-			return resource.NonRetryableError(errSetPrivilege)
-		}
-		return nil
+	err = rdbRetryOnConflict(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate), func() error {
+		_, errSet := rdbAPI.SetPrivilege(createReq, scw.WithContext(ctx))
+		return errSet
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -172,6 +164,10 @@ func resourceScalewayRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -204,20 +200,9 @@ func resourceScalewayRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceD
 		Permission:   rdb.Permission(d.Get("permission").(string)),
 	}
 
-	//  wrapper around StateChangeConf that will just retry the database creation
-	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	err = rdbRetryOnConflict(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate), func() error {
 		_, errSet := rdbAPI.SetPrivilege(updateReq, scw.WithContext(ctx))
-		if errSet != nil {
-			if is409Error(errSet) {
-				_, errWait := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
-				if errWait != nil {
-					return resource.NonRetryableError(errWait)
-				}
-				return resource.RetryableError(errSet)
-			}
-			return resource.NonRetryableError(errSet)
-		}
-		return nil
+		return errSet
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -239,6 +224,10 @@ func resourceScalewayRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
@@ -272,38 +261,25 @@ func resourceScalewayRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceD
 		Permission:   rdb.PermissionNone,
 	}
 
-	//  wrapper around StateChangeConf that will just retry the database creation
-	err = resource.RetryContext(ctx, defaultRdbInstanceTimeout, func() *resource.RetryError {
+	err = rdbRetryOnConflict(ctx, rdbAPI, region, instanceID, defaultRdbInstanceTimeout, func() error {
 		// check if user exist on retry
 		listUsers, errUserExist := rdbAPI.ListUsers(&rdb.ListUsersRequest{
 			Region:     region,
 			InstanceID: instanceID,
 			Name:       &userName,
 		}, scw.WithContext(ctx))
-		if err != nil {
-			if is404Error(err) {
-				d.SetId("")
+		if errUserExist != nil {
+			if is404Error(errUserExist) {
 				return nil
 			}
-			return resource.NonRetryableError(errUserExist)
+			return errUserExist
 		}
-
-		if listUsers != nil || len(listUsers.Users) == 0 {
-			d.SetId("")
+		if len(listUsers.Users) == 0 {
 			return nil
 		}
+
 		_, errSet := rdbAPI.SetPrivilege(updateReq, scw.WithContext(ctx))
-		if errSet != nil {
-			if is409Error(errSet) {
-				_, errWait := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
-				if errWait != nil {
-					return resource.NonRetryableError(errWait)
-				}
-				return resource.RetryableError(errSet)
-			}
-			return resource.NonRetryableError(errSet)
-		}
-		return nil
+		return errSet
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -18,6 +17,7 @@ func resourceScalewayRdbUser() *schema.Resource {
 		ReadContext:   resourceScalewayRdbUserRead,
 		UpdateContext: resourceScalewayRdbUserUpdate,
 		DeleteContext: resourceScalewayRdbUserDelete,
+		CustomizeDiff: customizeDiffRdbUserPassword,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,11 +27,12 @@ func resourceScalewayRdbUser() *schema.Resource {
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "Instance on which the user is created",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "Instance on which the user is created",
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -40,10 +41,11 @@ func resourceScalewayRdbUser() *schema.Resource {
 				ForceNew:    true,
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "Database user password",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "Database user password",
+				DiffSuppressFunc: diffSuppressFuncRdbUserPassword,
 			},
 			"is_admin": {
 				Type:        schema.TypeBool,
@@ -57,14 +59,25 @@ func resourceScalewayRdbUser() *schema.Resource {
 }
 
 func resourceScalewayRdbUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	rdbAPI := newRdbAPI(meta)
-	// resource depends on the instance locality
-	regionalID := d.Get("instance_id").(string)
-	region, instanceID, err := parseRegionalID(regionalID)
+	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// resource depends on the instance locality, instance_id may be given without its region
+	region, instanceID, err := expandRdbUserInstanceID(d.Get("instance_id").(string), region)
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
+	}
+
+	// Checked at plan time too, unless the password was not known yet
+	if d.Get("password").(string) == "" {
+		return diag.FromErr(fmt.Errorf("password is required to create a database user"))
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	ins, err := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -79,28 +92,15 @@ func resourceScalewayRdbUserCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var user *rdb.User
-	//  wrapper around StateChangeConf that will just retry write on database
-	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		currentUser, errCreateUser := rdbAPI.CreateUser(createReq, scw.WithContext(ctx))
-		if errCreateUser != nil {
-			if is409Error(errCreateUser) {
-				_, errWait := waitForRDBInstance(ctx, rdbAPI, region, ins.ID, d.Timeout(schema.TimeoutCreate))
-				if errWait != nil {
-					return resource.NonRetryableError(errWait)
-				}
-				return resource.RetryableError(errCreateUser)
-			}
-			return resource.NonRetryableError(errCreateUser)
-		}
-		// set database information
-		user = currentUser
-		return nil
+	err = rdbRetryOnConflict(ctx, rdbAPI, region, ins.ID, d.Timeout(schema.TimeoutCreate), func() error {
+		user, err = rdbAPI.CreateUser(createReq, scw.WithContext(ctx))
+		return err
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(resourceScalewayRdbUserID(region, expandID(instanceID), user.Name))
+	d.SetId(resourceScalewayRdbUserID(region, instanceID, user.Name))
 
 	return resourceScalewayRdbUserRead(ctx, d, meta)
 }
@@ -112,8 +112,12 @@ func resourceScalewayRdbUserRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutRead))
+	_, err = waitForRDBInstanceIfTransient(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -131,10 +135,25 @@ func resourceScalewayRdbUserRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	var user = res.Users[0]
+	// The name filter of the API is not an exact match, "foo" also lists "foobar"
+	var user *rdb.User
+	for _, u := range res.Users {
+		if u.Name == userName {
+			user = u
+			break
+		}
+	}
+	if user == nil {
+		d.SetId("")
+		return nil
+	}
+
 	_ = d.Set("instance_id", newRegionalID(region, instanceID).String())
 	_ = d.Set("name", user.Name)
 	_ = d.Set("is_admin", user.IsAdmin)
+	_ = d.Set("region", string(region))
+	// The API never returns the password: the one in the state is kept as is,
+	// and is left empty for imported users until it is set in the configuration.
 
 	d.SetId(resourceScalewayRdbUserID(region, instanceID, user.Name))
 
@@ -149,6 +168,10 @@ func resourceScalewayRdbUserUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -183,31 +206,22 @@ func resourceScalewayRdbUserDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		errDeleteUser := rdbAPI.DeleteUser(&rdb.DeleteUserRequest{
+	err = rdbRetryOnConflict(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete), func() error {
+		return rdbAPI.DeleteUser(&rdb.DeleteUserRequest{
 			Region:     region,
 			InstanceID: instanceID,
 			Name:       userName,
 		}, scw.WithContext(ctx))
-		if errDeleteUser != nil {
-			if is409Error(errDeleteUser) {
-				_, errWait := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
-				if errWait != nil {
-					return resource.NonRetryableError(errWait)
-				}
-				return resource.RetryableError(errDeleteUser)
-			}
-			return resource.NonRetryableError(errDeleteUser)
-		}
-		// set database information
-		return nil
 	})
-
 	if err != nil && !is404Error(err) {
 		return diag.FromErr(err)
 	}
@@ -215,6 +229,30 @@ func resourceScalewayRdbUserDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// customizeDiffRdbUserPassword requires a password to create a database user,
+// it is only optional to leave the password of an imported user unmanaged.
+func customizeDiffRdbUserPassword(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown("password") {
+		return nil
+	}
+	if diff.Get("password").(string) == "" {
+		return fmt.Errorf("password is required to create a database user")
+	}
+	return nil
+}
+
+// diffSuppressFuncRdbUserPassword suppresses the diff when the password is not managed by terraform.
+// This is the case of imported users, whose password can't be read, when no password is set in the configuration.
+func diffSuppressFuncRdbUserPassword(_, _, newValue string, d *schema.ResourceData) bool {
+	return d.Id() != "" && newValue == ""
+}
+
+// expandRdbUserInstanceID returns the region and the bare ID of the instance of a user,
+// instanceID may be given with or without its region, the region of the user is used for the latter.
+func expandRdbUserInstanceID(instanceID string, fallbackRegion scw.Region) (scw.Region, string, error) {
+	return parseRegionalID(datasourceNewRegionalizedID(instanceID, fallbackRegion))
+}
+
 // Build the resource identifier
 // The resource identifier format is "Region/InstanceId/UserName"
 func resourceScalewayRdbUserID(region scw.Region, instanceID string, userName string) (resourceID string) {
@@ -222,10 +260,10 @@ func resourceScalewayRdbUserID(region scw.Region, instanceID string, userName st
 }
 
 // Extract instance ID and username from the resource identifier.
-// The resource identifier format is "Region/InstanceId/UserName"
+// The resource identifier format is "Region/InstanceId/UserName", the user name may contain a "/"
 func resourceScalewayRdbUserParseID(resourceID string) (region scw.Region, instanceID string, userName string, err error) {
-	idParts := strings.Split(resourceID, "/")
-	if len(idParts) != 3 {
+	idParts := strings.SplitN(resourceID, "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		return "", "", "", fmt.Errorf("can't parse user resource id: %s", resourceID)
 	}
 	return scw.Region(idParts[0]), idParts[1], idParts[2], nil
@@ -7,6 +7,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccScalewayRdbUser_Basic(t *testing.T) {
@@ -66,6 +69,56 @@ func TestAccScalewayRdbUser_Basic(t *testing.T) {
 	})
 }
 
+func TestResourceScalewayRdbUserParseID(t *testing.T) {
+	region, instanceID, userName, err := resourceScalewayRdbUserParseID("fr-par/11111111-1111-1111-1111-111111111111/foo")
+	require.NoError(t, err)
+	assert.Equal(t, scw.RegionFrPar, region)
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", instanceID)
+	assert.Equal(t, "foo", userName)
+
+	_, _, userName, err = resourceScalewayRdbUserParseID("fr-par/11111111-1111-1111-1111-111111111111/foo/bar")
+	require.NoError(t, err)
+	assert.Equal(t, "foo/bar", userName)
+
+	_, _, _, err = resourceScalewayRdbUserParseID("fr-par/11111111-1111-1111-1111-111111111111")
+	assert.Error(t, err)
+
+	_, _, _, err = resourceScalewayRdbUserParseID("fr-par/11111111-1111-1111-1111-111111111111/")
+	assert.Error(t, err)
+}
+
+func TestExpandRdbUserInstanceID(t *testing.T) {
+	tests := []struct {
+		name       string
+		instanceID string
+	}{
+		{
+			name:       "bare ID",
+			instanceID: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			name:       "regional ID",
+			instanceID: "fr-par/11111111-1111-1111-1111-111111111111",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region, instanceID, err := expandRdbUserInstanceID(tt.instanceID, scw.RegionFrPar)
+			require.NoError(t, err)
+			assert.Equal(t, scw.RegionFrPar, region)
+			assert.Equal(t, "11111111-1111-1111-1111-111111111111", instanceID)
+			assert.Equal(t, "fr-par/11111111-1111-1111-1111-111111111111/foo", resourceScalewayRdbUserID(region, instanceID, "foo"))
+		})
+	}
+
+	// The region of a regional ID wins over the region of the user
+	region, instanceID, err := expandRdbUserInstanceID("nl-ams/11111111-1111-1111-1111-111111111111", scw.RegionFrPar)
+	require.NoError(t, err)
+	assert.Equal(t, scw.RegionNlAms, region)
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", instanceID)
+}
+
 func testAccCheckRdbUserExists(tt *TestTools, instance string, user string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		instanceResource, ok := state.RootModule().Resources[instance]
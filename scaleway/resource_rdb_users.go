@@ -0,0 +1,347 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayRdbUsers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayRdbUsersCreate,
+		ReadContext:   resourceScalewayRdbUsersRead,
+		UpdateContext: resourceScalewayRdbUsersUpdate,
+		DeleteContext: resourceScalewayRdbUsersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultRdbInstanceTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "Instance on which the users are created",
+			},
+			"user": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Database users to manage on the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Database user name",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Database user password",
+						},
+						"is_admin": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Grant admin permissions to the database user",
+						},
+					},
+				},
+			},
+			"users": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the managed user names to their admin flag",
+				Elem: &schema.Schema{
+					Type: schema.TypeBool,
+				},
+			},
+			// Common
+			"region": regionSchema(),
+		},
+	}
+}
+
+func resourceScalewayRdbUsersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// instance_id may be given without its region, in which case the resource one is used
+	instanceID := datasourceNewRegionalizedID(d.Get("instance_id"), region)
+	region, ID, err := parseRegionalID(instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKey := rdbInstanceMutexKey(region, ID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
+	_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The ID is set first so that the users created before a failure are kept in the state
+	d.SetId(instanceID)
+
+	users := expandRdbUsers(d.Get("user"))
+	created := []interface{}(nil)
+	for _, name := range rdbUserNames(users) {
+		err = rdbUsersCreate(ctx, rdbAPI, region, ID, users[name], d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			_ = d.Set("user", created)
+			return diag.FromErr(err)
+		}
+		created = append(created, map[string]interface{}{
+			"name":     users[name].Name,
+			"password": users[name].Password,
+			"is_admin": users[name].IsAdmin,
+		})
+	}
+
+	return resourceScalewayRdbUsersRead(ctx, d, meta)
+}
+
+func resourceScalewayRdbUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, instanceID, err := rdbAPIWithRegionAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := rdbAPI.ListUsers(&rdb.ListUsersRequest{
+		Region:     region,
+		InstanceID: instanceID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// The API never returns the passwords: the ones in the state are kept as is.
+	// On import, the state is empty and every user of the instance is read.
+	managed := expandRdbUsers(d.Get("user"))
+	users := []interface{}(nil)
+	admins := map[string]interface{}{}
+	for _, user := range res.Users {
+		password := ""
+		if len(managed) > 0 {
+			stateUser, ok := managed[user.Name]
+			if !ok {
+				continue
+			}
+			password = stateUser.Password
+		}
+		users = append(users, map[string]interface{}{
+			"name":     user.Name,
+			"password": password,
+			"is_admin": user.IsAdmin,
+		})
+		admins[user.Name] = user.IsAdmin
+	}
+
+	id := newRegionalID(region, instanceID).String()
+	d.SetId(id)
+	_ = d.Set("instance_id", id)
+	_ = d.Set("user", users)
+	_ = d.Set("users", admins)
+	_ = d.Set("region", string(region))
+
+	return nil
+}
+
+func resourceScalewayRdbUsersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, instanceID, err := rdbAPIWithRegionAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
+	if d.HasChange("user") {
+		_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		oldUsers, newUsers := d.GetChange("user")
+		before := expandRdbUsers(oldUsers)
+		after := expandRdbUsers(newUsers)
+
+		for _, name := range rdbUserNames(before) {
+			if _, ok := after[name]; ok {
+				continue
+			}
+			err = rdbUsersDelete(ctx, rdbAPI, region, instanceID, name, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, name := range rdbUserNames(after) {
+			user := after[name]
+			previous, ok := before[name]
+			if !ok {
+				err = rdbUsersCreate(ctx, rdbAPI, region, instanceID, user, d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				continue
+			}
+
+			req := &rdb.UpdateUserRequest{
+				Region:     region,
+				InstanceID: instanceID,
+				Name:       name,
+			}
+			// An empty password is left unmanaged, as for imported users
+			if user.Password != previous.Password && user.Password != "" {
+				req.Password = scw.StringPtr(user.Password)
+			}
+			if user.IsAdmin != previous.IsAdmin {
+				req.IsAdmin = scw.BoolPtr(user.IsAdmin)
+			}
+			if req.Password == nil && req.IsAdmin == nil {
+				continue
+			}
+
+			err = rdbRetryOnConflict(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate), func() error {
+				_, err := rdbAPI.UpdateUser(req, scw.WithContext(ctx))
+				return err
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceScalewayRdbUsersRead(ctx, d, meta)
+}
+
+func resourceScalewayRdbUsersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, instanceID, err := rdbAPIWithRegionAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKey := rdbInstanceMutexKey(region, instanceID)
+	rdbInstanceMutexKV.Lock(mutexKey)
+	defer rdbInstanceMutexKV.Unlock(mutexKey)
+
+	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	for _, name := range rdbUserNames(expandRdbUsers(d.Get("user"))) {
+		err = rdbUsersDelete(ctx, rdbAPI, region, instanceID, name, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// rdbUsersCreate creates a single user, the users being created one after the other on the same instance.
+func rdbUsersCreate(ctx context.Context, api *rdb.API, region scw.Region, instanceID string, user *rdb.CreateUserRequest, timeout time.Duration) error {
+	if user.Password == "" {
+		return fmt.Errorf("password is required to create database user %s", user.Name)
+	}
+	user.Region = region
+	user.InstanceID = instanceID
+
+	return rdbRetryOnConflict(ctx, api, region, instanceID, timeout, func() error {
+		_, err := api.CreateUser(user, scw.WithContext(ctx))
+		// A conflict is retried while the instance is busy, but not when the user already exists
+		if is409Error(err) {
+			exists, errList := rdbUserExists(ctx, api, region, instanceID, user.Name)
+			if errList == nil && exists {
+				return fmt.Errorf("database user %s already exists on instance %s, import it instead: %s", user.Name, instanceID, err)
+			}
+		}
+		return err
+	})
+}
+
+func rdbUserExists(ctx context.Context, api *rdb.API, region scw.Region, instanceID string, name string) (bool, error) {
+	res, err := api.ListUsers(&rdb.ListUsersRequest{
+		Region:     region,
+		InstanceID: instanceID,
+		Name:       &name,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return false, err
+	}
+	for _, user := range res.Users {
+		if user.Name == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func rdbUsersDelete(ctx context.Context, api *rdb.API, region scw.Region, instanceID string, name string, timeout time.Duration) error {
+	err := rdbRetryOnConflict(ctx, api, region, instanceID, timeout, func() error {
+		return api.DeleteUser(&rdb.DeleteUserRequest{
+			Region:     region,
+			InstanceID: instanceID,
+			Name:       name,
+		}, scw.WithContext(ctx))
+	})
+	if err != nil && !is404Error(err) {
+		return err
+	}
+
+	return nil
+}
+
+// expandRdbUsers returns the users of the set indexed by name
+func expandRdbUsers(i interface{}) map[string]*rdb.CreateUserRequest {
+	users := map[string]*rdb.CreateUserRequest{}
+	for _, raw := range i.(*schema.Set).List() {
+		user := raw.(map[string]interface{})
+		users[user["name"].(string)] = &rdb.CreateUserRequest{
+			Name:     user["name"].(string),
+			Password: user["password"].(string),
+			IsAdmin:  user["is_admin"].(bool),
+		}
+	}
+
+	return users
+}
+
+// rdbUserNames returns the sorted user names so that the users are always handled in the same order
+func rdbUserNames(users map[string]*rdb.CreateUserRequest) []string {
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	redis "github.com/scaleway/scaleway-sdk-go/api/redis/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -16,11 +17,12 @@ func resourceScalewayRedisCluster() *schema.Resource {
 		ReadContext:   resourceScalewayRedisClusterRead,
 		UpdateContext: resourceScalewayRedisClusterUpdate,
 		DeleteContext: resourceScalewayRedisClusterDelete,
+		CustomizeDiff: customizeDiffRedisClusterSize,
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultRedisClusterTimeout),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateZonedID,
 		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -63,7 +65,7 @@ func resourceScalewayRedisCluster() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Computed:    true,
-				Description: "Number of nodes for the cluster.",
+				Description: "Number of nodes for the cluster, it can only be increased.",
 			},
 			"tls_enabled": {
 				Type:        schema.TypeBool,
@@ -93,9 +95,10 @@ func resourceScalewayRedisCluster() *schema.Resource {
 							Computed:    true,
 						},
 						"ip": {
-							Type:        schema.TypeString,
-							Description: "IPv4 network address of the rule (IP network in a CIDR format).",
-							Required:    true,
+							Type:             schema.TypeString,
+							Description:      "IPv4 network address of the rule (IP network in a CIDR format).",
+							Required:         true,
+							DiffSuppressFunc: diffSuppressFuncIPNet,
 						},
 						"description": {
 							Type:        schema.TypeString,
@@ -114,6 +117,73 @@ func resourceScalewayRedisCluster() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"private_network": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Private networks to expose the cluster on, the cluster is exposed publicly when none is set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validationUUIDorUUIDWithLocality(),
+							DiffSuppressFunc: diffSuppressFuncLocality,
+							Description:      "UUID of the private network to be connected to the cluster",
+						},
+						"service_ips": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+							Description: "Endpoint IPv4 addresses in CIDR notation, one per node of the cluster",
+						},
+						// Computed
+						"endpoint_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the endpoint",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TCP port of the endpoint",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone of the private network",
+						},
+					},
+				},
+			},
+			"public_network": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Public endpoint of the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the endpoint",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TCP port of the endpoint",
+						},
+						"ips": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IPv4 addresses of the endpoint",
+						},
+					},
+				},
+			},
 			// Common
 			"zone":       zoneSchema(),
 			"project_id": projectIDSchema(),
@@ -121,6 +191,16 @@ func resourceScalewayRedisCluster() *schema.Resource {
 	}
 }
 
+func customizeDiffRedisClusterSize(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("cluster_size") || !diff.NewValueKnown("cluster_size") {
+		return nil
+	}
+
+	oldSize, newSize := diff.GetChange("cluster_size")
+
+	return validateRedisClusterSizeChange(oldSize.(int), newSize.(int))
+}
+
 func resourceScalewayRedisClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	redisAPI, zone, err := redisAPIWithZone(d, meta)
 	if err != nil {
@@ -161,6 +241,14 @@ func resourceScalewayRedisClusterCreate(ctx context.Context, d *schema.ResourceD
 	if settingsExist {
 		createReq.ClusterSettings = expandRedisSettings(settings)
 	}
+	privateNetworks, privateNetworksExist := d.GetOk("private_network")
+	if privateNetworksExist {
+		endpoints, err := expandRedisPrivateNetwork(privateNetworks)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createReq.Endpoints = endpoints
+	}
 
 	res, err := redisAPI.CreateCluster(createReq, scw.WithContext(ctx))
 	if err != nil {
@@ -204,10 +292,18 @@ func resourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceDat
 	_ = d.Set("project_id", cluster.ProjectID)
 	_ = d.Set("version", cluster.Version)
 	_ = d.Set("cluster_size", cluster.ClusterSize)
+	_ = d.Set("tls_enabled", cluster.TLSEnabled)
 	_ = d.Set("created_at", cluster.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("updated_at", cluster.UpdatedAt.Format(time.RFC3339))
 	_ = d.Set("acl", flattenRedisACLs(cluster.ACLRules))
 	_ = d.Set("settings", flattenRedisSettings(cluster.ClusterSettings))
+	_ = d.Set("public_network", flattenRedisPublicNetwork(cluster.Endpoints))
+
+	privateNetworks, err := flattenRedisPrivateNetwork(cluster.Endpoints)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("private_network", privateNetworks)
 
 	if len(cluster.Tags) > 0 {
 		_ = d.Set("tags", cluster.Tags)
@@ -251,6 +347,12 @@ func resourceScalewayRedisClusterUpdate(ctx context.Context, d *schema.ResourceD
 			return diagnostics
 		}
 	}
+	if d.HasChange("private_network") {
+		diagnostics := resourceScalewayRedisClusterUpdateEndpoints(ctx, d, redisAPI, zone, ID)
+		if diagnostics != nil {
+			return diagnostics
+		}
+	}
 
 	_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
@@ -341,6 +443,33 @@ func resourceScalewayRedisClusterUpdateSettings(ctx context.Context, d *schema.R
 	return nil
 }
 
+func resourceScalewayRedisClusterUpdateEndpoints(ctx context.Context, d *schema.ResourceData, redisAPI *redis.API, zone scw.Zone, clusterID string) diag.Diagnostics {
+	endpoints, err := expandRedisPrivateNetwork(d.Get("private_network"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Without private network the cluster goes back to its public endpoint
+	if len(endpoints) == 0 {
+		endpoints = []*redis.EndpointSpec{{PublicNetwork: &redis.EndpointSpecPublicNetworkSpec{}}}
+	}
+
+	_, err = waitForRedisCluster(ctx, redisAPI, zone, clusterID, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = redisAPI.SetEndpoints(&redis.SetEndpointsRequest{
+		Zone:      zone,
+		ClusterID: clusterID,
+		Endpoints: endpoints,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
 func resourceScalewayRedisClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	redisAPI, zone, ID, err := redisAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
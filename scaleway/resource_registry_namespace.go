@@ -16,7 +16,7 @@ func resourceScalewayRegistryNamespace() *schema.Resource {
 		UpdateContext: resourceScalewayRegistryNamespaceUpdate,
 		DeleteContext: resourceScalewayRegistryNamespaceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateRegionalID,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultRegistryNamespaceTimeout),
@@ -44,6 +44,16 @@ func resourceScalewayRegistryNamespace() *schema.Resource {
 				Computed:    true,
 				Description: "The endpoint reachable by docker",
 			},
+			"size_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total size of the images of the namespace in bytes",
+			},
+			"image_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of images in the namespace",
+			},
 			"region":          regionSchema(),
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
@@ -99,6 +109,8 @@ func resourceScalewayRegistryNamespaceRead(ctx context.Context, d *schema.Resour
 	_ = d.Set("project_id", ns.ProjectID)
 	_ = d.Set("is_public", ns.IsPublic)
 	_ = d.Set("endpoint", ns.Endpoint)
+	_ = d.Set("size_bytes", int(ns.Size))
+	_ = d.Set("image_count", int(ns.ImageCount))
 	_ = d.Set("region", ns.Region)
 
 	return nil
@@ -23,22 +23,25 @@ func resourceScalewayVPCGatewayNetwork() *schema.Resource {
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "The ID of the public gateway where connect to",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The ID of the public gateway where connect to",
 			},
 			"private_network_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "The ID of the private network where connect to",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The ID of the private network where connect to",
 			},
 			"dhcp_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "The ID of the public gateway DHCP config",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The ID of the public gateway DHCP config",
 			},
 			"enable_masquerade": {
 				Type:        schema.TypeBool,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -30,6 +31,7 @@ func resourceScalewayVPCPublicGateway() *schema.Resource {
 			"type": {
 				Type:             schema.TypeString,
 				Required:         true,
+				ForceNew:         true,
 				Description:      "gateway type",
 				DiffSuppressFunc: diffSuppressFuncIgnoreCase,
 			},
@@ -48,6 +50,19 @@ func resourceScalewayVPCPublicGateway() *schema.Resource {
 				Description:      "attach an existing IP to the gateway",
 				DiffSuppressFunc: diffSuppressFuncLocality,
 			},
+			"bastion_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable SSH bastion on the gateway",
+			},
+			"bastion_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "The port on which the SSH bastion will listen",
+			},
 			"tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -70,6 +85,11 @@ func resourceScalewayVPCPublicGateway() *schema.Resource {
 				Computed:    true,
 				Description: "The date and time of the last update of the public gateway",
 			},
+			"bastion_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The host of the SSH bastion, when it is enabled",
+			},
 		},
 	}
 }
@@ -106,6 +126,29 @@ func resourceScalewayVPCPublicGatewayCreate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	// The bastion can only be configured once the gateway exists
+	bastionPort, hasBastionPort := d.GetOk("bastion_port")
+	if d.Get("bastion_enabled").(bool) || hasBastionPort {
+		updateRequest := &vpcgw.UpdateGatewayRequest{
+			GatewayID:     gateway.ID,
+			Zone:          zone,
+			EnableBastion: scw.BoolPtr(d.Get("bastion_enabled").(bool)),
+		}
+		if hasBastionPort {
+			updateRequest.BastionPort = expandUint32Ptr(bastionPort)
+		}
+
+		_, err = vpcgwAPI.UpdateGateway(updateRequest, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForVPCPublicGateway(ctx, vpcgwAPI, zone, gateway.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayVPCPublicGatewayRead(ctx, d, meta)
 }
 
@@ -125,6 +168,9 @@ func resourceScalewayVPCPublicGatewayRead(ctx context.Context, d *schema.Resourc
 	}
 
 	_ = d.Set("name", gateway.Name)
+	if gateway.Type != nil {
+		_ = d.Set("type", gateway.Type.Name)
+	}
 	_ = d.Set("organization_id", gateway.OrganizationID)
 	_ = d.Set("project_id", gateway.ProjectID)
 	_ = d.Set("created_at", gateway.CreatedAt.Format(time.RFC3339))
@@ -133,6 +179,13 @@ func resourceScalewayVPCPublicGatewayRead(ctx context.Context, d *schema.Resourc
 	_ = d.Set("tags", gateway.Tags)
 	_ = d.Set("upstream_dns_servers", gateway.UpstreamDNSServers)
 	_ = d.Set("ip_id", newZonedID(gateway.Zone, gateway.IP.ID).String())
+	_ = d.Set("bastion_enabled", gateway.BastionEnabled)
+	_ = d.Set("bastion_port", int(gateway.BastionPort))
+	if gateway.BastionEnabled && gateway.IP != nil {
+		_ = d.Set("bastion_host", gateway.IP.Address.String())
+	} else {
+		_ = d.Set("bastion_host", "")
+	}
 
 	return nil
 }
@@ -148,7 +201,7 @@ func resourceScalewayVPCPublicGatewayUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges("name", "tags", "upstream_dns_servers") {
+	if d.HasChanges("name", "tags", "upstream_dns_servers", "bastion_enabled", "bastion_port") {
 		updateRequest := &vpcgw.UpdateGatewayRequest{
 			GatewayID:          gateway.ID,
 			Zone:               gateway.Zone,
@@ -157,12 +210,38 @@ func resourceScalewayVPCPublicGatewayUpdate(ctx context.Context, d *schema.Resou
 			UpstreamDNSServers: scw.StringsPtr(expandStrings(d.Get("upstream_dns_servers"))),
 		}
 
+		if d.HasChange("bastion_enabled") {
+			updateRequest.EnableBastion = scw.BoolPtr(d.Get("bastion_enabled").(bool))
+		}
+
+		if d.HasChange("bastion_port") {
+			updateRequest.BastionPort = expandUint32Ptr(d.Get("bastion_port"))
+		}
+
 		_, err = vpcgwAPI.UpdateGateway(updateRequest, scw.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	if d.HasChange("ip_id") {
+		if ipID, ok := d.GetOk("ip_id"); ok {
+			_, err = waitForVPCPublicGateway(ctx, vpcgwAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			_, err = vpcgwAPI.UpdateIP(&vpcgw.UpdateIPRequest{
+				IPID:      expandZonedID(ipID).ID,
+				Zone:      zone,
+				GatewayID: scw.StringPtr(gateway.ID),
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	_, err = waitForVPCPublicGateway(ctx, vpcgwAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return diag.FromErr(err)
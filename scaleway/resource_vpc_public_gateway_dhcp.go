@@ -22,6 +22,7 @@ func resourceScalewayVPCPublicGatewayDHCP() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 0,
+		CustomizeDiff: customizeDiffVPCPublicGatewayDHCPTimers,
 		Schema: map[string]*schema.Schema{
 			"project_id": projectIDSchema(),
 			"zone":       zoneSchema(),
@@ -124,6 +125,19 @@ func resourceScalewayVPCPublicGatewayDHCP() *schema.Resource {
 	}
 }
 
+func customizeDiffVPCPublicGatewayDHCPTimers(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.HasChanges("renew_timer", "rebind_timer", "valid_lifetime") {
+		return nil
+	}
+
+	// Timers left to their default are unknown until the DHCP is created, they are then zero and not checked.
+	return validateVPCPublicGatewayDHCPTimers(
+		diff.Get("renew_timer").(int),
+		diff.Get("rebind_timer").(int),
+		diff.Get("valid_lifetime").(int),
+	)
+}
+
 func resourceScalewayVPCPublicGatewayDHCPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcgwAPI, zone, err := vpcgwAPIWithZone(d, meta)
 	if err != nil {
@@ -220,16 +234,14 @@ func resourceScalewayVPCPublicGatewayDHCPRead(ctx context.Context, d *schema.Res
 		_ = d.Set("dns_search", flattenSliceString(dhcp.DNSSearch))
 	}
 
-	if len(dhcp.DNSServersOverride) > 0 {
-		_ = d.Set("dns_servers_override", flattenSliceString(dhcp.DNSServersOverride))
-	}
+	_ = d.Set("dns_servers_override", flattenVPCGatewayDHCPDNSServersOverride(dhcp.DNSServersOverride, dhcp.Address.String(), dhcp.PushDNSServer, expandStrings(d.Get("dns_servers_override"))))
 
 	_ = d.Set("address", dhcp.Address.String())
 	_ = d.Set("created_at", dhcp.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("dns_local_name", dhcp.DNSLocalName)
 	_ = d.Set("enable_dynamic", dhcp.EnableDynamic)
 	_ = d.Set("organization_id", dhcp.OrganizationID)
-	_ = d.Set("pool_high", dhcp.PoolLow.String())
+	_ = d.Set("pool_high", dhcp.PoolHigh.String())
 	_ = d.Set("pool_low", dhcp.PoolLow.String())
 	_ = d.Set("project_id", dhcp.ProjectID)
 	_ = d.Set("push_default_route", dhcp.PushDefaultRoute)
@@ -303,10 +315,8 @@ func resourceScalewayVPCPublicGatewayDHCPUpdate(ctx context.Context, d *schema.R
 		req.PoolHigh = scw.IPPtr(net.ParseIP(d.Get("pool_high").(string)))
 	}
 
-	if d.HasChanges("dns_servers_override") {
-		if dnsServerOverride, ok := d.GetOk("dns_servers_override"); ok {
-			req.DNSServersOverride = expandStringsPtr(dnsServerOverride)
-		}
+	if d.HasChange("dns_servers_override") {
+		req.DNSServersOverride = expandVPCGatewayDHCPDNSServersOverride(d.Get("dns_servers_override"))
 	}
 
 	if d.HasChanges("dns_search") {
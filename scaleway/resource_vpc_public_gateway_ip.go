@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -153,18 +154,21 @@ func resourceScalewayVPCPublicGatewayIPDelete(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	var warnings diag.Diagnostics
-	err = vpcgwAPI.DeleteIP(&vpcgw.DeleteIPRequest{
-		IPID: ID,
-		Zone: zone,
-	}, scw.WithContext(ctx))
-	if err != nil {
-		if is409Error(err) || is412Error(err) || is404Error(err) {
-			return append(warnings, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  err.Error(),
-			})
+	// The IP cannot be deleted while a gateway still holds it: retry while it is being detached
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		errDelete := vpcgwAPI.DeleteIP(&vpcgw.DeleteIPRequest{
+			IPID: ID,
+			Zone: zone,
+		}, scw.WithContext(ctx))
+		if errDelete != nil {
+			if is409Error(errDelete) || is412Error(errDelete) {
+				return resource.RetryableError(errDelete)
+			}
+			return resource.NonRetryableError(errDelete)
 		}
+		return nil
+	})
+	if err != nil && !is404Error(err) {
 		return diag.FromErr(err)
 	}
 
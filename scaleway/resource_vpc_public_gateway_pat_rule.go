@@ -28,10 +28,11 @@ func resourceScalewayVPCPublicGatewayPATRule() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validationUUIDorUUIDWithLocality(),
-				Description:  "The ID of the gateway this PAT rule is applied to",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The ID of the gateway this PAT rule is applied to",
 			},
 			"private_ip": {
 				Type:         schema.TypeString,
@@ -2,8 +2,11 @@ package scaleway
 
 import (
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/scaleway/scaleway-sdk-go/validation"
+	"golang.org/x/crypto/ssh"
 )
 
 // validationUUID validates the schema is a UUID or the combination of a locality and a UUID
@@ -45,3 +48,55 @@ func validationUUIDWithLocality() func(interface{}, string) ([]string, []error)
 		return validationUUID()(subUUID, key)
 	}
 }
+
+// validationSSHPublicKey validates the schema is a public key in the authorized_keys format
+// e.g. "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIK... user@host".
+func validationSSHPublicKey() func(interface{}, string) ([]string, []error) {
+	return func(v interface{}, key string) (warnings []string, errors []error) {
+		publicKey, isString := v.(string)
+		if !isString {
+			return nil, []error{fmt.Errorf("invalid SSH public key for key '%s': not a string", key)}
+		}
+
+		_, _, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.Trim(publicKey, "\n")))
+		if err != nil {
+			return nil, []error{fmt.Errorf("invalid SSH public key for key '%s': %s", key, err)}
+		}
+
+		return
+	}
+}
+
+// validationPrivateServiceIPNet validates the schema is a private IPv4 host address with the prefix of its subnet
+// e.g. "192.168.1.42/24": the address of the subnet itself and its broadcast address are rejected.
+func validationPrivateServiceIPNet() func(interface{}, string) ([]string, []error) {
+	return func(v interface{}, key string) (warnings []string, errors []error) {
+		raw, isString := v.(string)
+		if !isString {
+			return nil, []error{fmt.Errorf("invalid service IP for key '%s': not a string", key)}
+		}
+
+		ip, subnet, err := net.ParseCIDR(raw)
+		if err != nil || ip.To4() == nil {
+			return nil, []error{fmt.Errorf("invalid service IP for key '%s': '%s' should be an IPv4 with a CIDR prefix", key, raw)}
+		}
+
+		if !ip.IsPrivate() {
+			return nil, []error{fmt.Errorf("invalid service IP for key '%s': '%s' is not a private IP", key, raw)}
+		}
+
+		ones, bits := subnet.Mask.Size()
+		if bits-ones < 2 {
+			return
+		}
+		broadcast := make(net.IP, len(subnet.IP))
+		for i := range subnet.IP {
+			broadcast[i] = subnet.IP[i] | ^subnet.Mask[i]
+		}
+		if ip.Equal(subnet.IP) || ip.Equal(broadcast) {
+			return nil, []error{fmt.Errorf("invalid service IP for key '%s': '%s' is not a host address of the subnet %s", key, raw, subnet.String())}
+		}
+
+		return
+	}
+}
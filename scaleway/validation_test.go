@@ -64,3 +64,46 @@ func TestValidationUUIDWithLocalityWithInvalidUUIDReturnError(t *testing.T) {
 		assert.Len(errors, 1, uuid)
 	}
 }
+
+func TestValidationSSHPublicKeyWithValidKeyReturnNothing(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, key := range []string{
+		"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEEYrzDOZmhItdKaDAEqJQ4ORS2GyBMtBozYsK5kiXXX opensource@scaleway.com",
+		"\n\nssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDjfkdWCwkYlVQMDUfiZlVrmjaGOfBYnmkucssae8Iup opensource@scaleway.com\n\n",
+	} {
+		warnings, errors := validationSSHPublicKey()(key, "key")
+		assert.Empty(warnings)
+		assert.Empty(errors)
+	}
+}
+
+func TestValidationSSHPublicKeyWithInvalidKeyReturnError(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, key := range []string{"", "ssh-ed25519", "ssh-ed25519 not-base64 opensource@scaleway.com"} {
+		warnings, errors := validationSSHPublicKey()(key, "key")
+		assert.Empty(warnings)
+		assert.Len(errors, 1)
+	}
+}
+
+func TestValidationPrivateServiceIPNetWithValidIPReturnNothing(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, ipNet := range []string{"192.168.1.42/24", "10.0.0.1/8", "172.16.4.1/30", "192.168.0.0/31"} {
+		warnings, errors := validationPrivateServiceIPNet()(ipNet, "key")
+		assert.Empty(warnings)
+		assert.Empty(errors, ipNet)
+	}
+}
+
+func TestValidationPrivateServiceIPNetWithInvalidIPReturnError(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, ipNet := range []string{"", "192.168.1.42", "51.15.1.1/24", "192.168.1.0/24", "192.168.1.255/24", "fd00::1/64"} {
+		warnings, errors := validationPrivateServiceIPNet()(ipNet, "key")
+		assert.Empty(warnings)
+		assert.Len(errors, 1, ipNet)
+	}
+}